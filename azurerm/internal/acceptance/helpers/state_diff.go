@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AttributeDiff is a difference in a single (flatmapped) attribute between two states
+type AttributeDiff struct {
+	// Key is the flatmapped key of the attribute, e.g. `tags.%` or `network_rules.0.ip_rules.#`
+	Key string
+
+	// Before is the value of this attribute in the original state, or nil if it wasn't present
+	Before *string
+
+	// After is the value of this attribute in the new state, or nil if it isn't present
+	After *string
+}
+
+func (d AttributeDiff) String() string {
+	if d.Before == nil {
+		return fmt.Sprintf("%q was not previously set but is now %q", d.Key, *d.After)
+	}
+	if d.After == nil {
+		return fmt.Sprintf("%q (value %q) is no longer set", d.Key, *d.Before)
+	}
+
+	return fmt.Sprintf("%q changed from %q to %q", d.Key, *d.Before, *d.After)
+}
+
+// DiffAttributes compares two sets of flatmapped attributes, returning the differences ordered by key. The `id`
+// and `timeouts` attributes are always ignored, as are any keys which are equal to or nested within an ignored key
+func DiffAttributes(before, after map[string]string, ignore ...string) []AttributeDiff {
+	ignore = append(ignore, "id", "timeouts")
+
+	keys := make(map[string]struct{})
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	output := make([]AttributeDiff, 0)
+	for key := range keys {
+		if isIgnored(key, ignore) {
+			continue
+		}
+
+		beforeVal, beforeOk := before[key]
+		afterVal, afterOk := after[key]
+		if beforeOk && afterOk && beforeVal == afterVal {
+			continue
+		}
+
+		diff := AttributeDiff{
+			Key: key,
+		}
+		if beforeOk {
+			diff.Before = &beforeVal
+		}
+		if afterOk {
+			diff.After = &afterVal
+		}
+		output = append(output, diff)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Key < output[j].Key
+	})
+	return output
+}
+
+// ChangedFields returns the sorted, distinct top-level schema fields which have changed within the diffs
+func ChangedFields(diffs []AttributeDiff) []string {
+	fields := make(map[string]struct{})
+	for _, diff := range diffs {
+		fields[strings.Split(diff.Key, ".")[0]] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for field := range fields {
+		output = append(output, field)
+	}
+	sort.Strings(output)
+	return output
+}
+
+func isIgnored(key string, ignore []string) bool {
+	for _, v := range ignore {
+		if key == v || strings.HasPrefix(key, v+".") {
+			return true
+		}
+	}
+
+	return false
+}
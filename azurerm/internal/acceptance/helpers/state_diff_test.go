@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffAttributes(t *testing.T) {
+	before := map[string]string{
+		"id":                   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+		"name":                 "group1",
+		"location":             "westeurope",
+		"tags.%":               "1",
+		"tags.env":             "prod",
+		"timeouts.create":      "30m",
+		"network_rules.#":      "1",
+		"network_rules.0.name": "rule1",
+		"secret":               "hello",
+	}
+	after := map[string]string{
+		"id":              "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+		"name":            "group1",
+		"location":        "westeurope",
+		"tags.%":          "2",
+		"tags.env":        "prod",
+		"tags.owner":      "someone",
+		"network_rules.#": "0",
+	}
+
+	diffs := DiffAttributes(before, after, "secret")
+
+	keys := make([]string, 0)
+	for _, diff := range diffs {
+		keys = append(keys, diff.Key)
+	}
+	expectedKeys := []string{"network_rules.#", "network_rules.0.name", "tags.%", "tags.owner"}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Fatalf("Expected the keys %+v but got %+v", expectedKeys, keys)
+	}
+
+	if diffs[1].After != nil || *diffs[1].Before != "rule1" {
+		t.Fatalf("Expected %q to be removed but got %s", diffs[1].Key, diffs[1].String())
+	}
+	if diffs[3].Before != nil || *diffs[3].After != "someone" {
+		t.Fatalf("Expected %q to be added but got %s", diffs[3].Key, diffs[3].String())
+	}
+
+	fields := ChangedFields(diffs)
+	expectedFields := []string{"network_rules", "tags"}
+	if !reflect.DeepEqual(fields, expectedFields) {
+		t.Fatalf("Expected the fields %+v but got %+v", expectedFields, fields)
+	}
+}
+
+func TestDiffAttributesNoChanges(t *testing.T) {
+	attributes := map[string]string{
+		"id":   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+		"name": "group1",
+	}
+
+	if diffs := DiffAttributes(attributes, attributes); len(diffs) != 0 {
+		t.Fatalf("Expected no differences but got %+v", diffs)
+	}
+}
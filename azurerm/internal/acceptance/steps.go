@@ -3,13 +3,16 @@ package acceptance
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/helpers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
 )
 
 type DisappearsStepData struct {
@@ -117,3 +120,188 @@ func (td TestData) RequiresImportErrorStep(configBuilder func(data TestData) str
 		ExpectError: RequiresImportError(td.ResourceType),
 	}
 }
+
+type DriftStepData struct {
+	// Config is a function which returns the Terraform Configuration which should be used for this step
+	Config func(data TestData) string
+
+	// TestResource is a reference to a TestResource which is used to confirm the resource exists
+	TestResource types.TestResource
+
+	// Mutate is a function which changes the resource out-of-band using the Azure SDK clients
+	Mutate ClientCheckFunc
+
+	// ExpectedChanges is the list of top-level schema fields which should change as a result of Mutate
+	ExpectedChanges []string
+}
+
+// DriftStep returns a Test Step which first confirms the resource exists, then changes it out-of-band
+// and confirms that the Read function detects exactly the expected fields as having changed - and as
+// such that the plan at the end of this step contains these changes
+func (td TestData) DriftStep(data DriftStepData) resource.TestStep {
+	config := data.Config(td)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			func(state *terraform.State) error {
+				client, err := buildClient()
+				if err != nil {
+					return fmt.Errorf("building client: %+v", err)
+				}
+				return helpers.ExistsInAzure(client, data.TestResource, td.ResourceName)(state)
+			},
+			func(state *terraform.State) error {
+				rs, ok := state.RootModule().Resources[td.ResourceName]
+				if !ok {
+					return fmt.Errorf("%q was not found in the state", td.ResourceName)
+				}
+
+				client, err := buildClient()
+				if err != nil {
+					return fmt.Errorf("building client: %+v", err)
+				}
+
+				if err := data.Mutate(client.StopContext, client, rs.Primary); err != nil {
+					return fmt.Errorf("mutating %q: %+v", td.ResourceName, err)
+				}
+
+				refreshed, err := refreshResource(client, td.ResourceType, rs.Primary)
+				if err != nil {
+					return err
+				}
+				if refreshed == nil {
+					return fmt.Errorf("%q was removed from the state during the refresh - but should have been mutated", td.ResourceName)
+				}
+
+				diffs := helpers.DiffAttributes(rs.Primary.Attributes, refreshed.Attributes)
+				actual := helpers.ChangedFields(diffs)
+				expected := helpers.ChangedFields(fieldsAsDiffs(data.ExpectedChanges))
+				if !reflect.DeepEqual(actual, expected) {
+					return fmt.Errorf("expected the fields %+v to have drifted for %q but got %+v:\n\n%s", expected, td.ResourceName, actual, diffsAsString(diffs))
+				}
+
+				return nil
+			},
+		),
+		ExpectNonEmptyPlan: true,
+	}
+}
+
+// ImportRoundTripStep returns a Test Step which imports the resource using its ID and then
+// compares the full state before and after the import - reporting any fields which aren't
+// flattened in the Read function. Unlike ImportStep this runs the Importer and the Read
+// function directly, so that each field which doesn't round-trip is reported.
+func (td TestData) ImportRoundTripStep(configBuilder func(data TestData) string, ignore ...string) resource.TestStep {
+	config := configBuilder(td)
+	return resource.TestStep{
+		Config: config,
+		Check: func(state *terraform.State) error {
+			rs, ok := state.RootModule().Resources[td.ResourceName]
+			if !ok {
+				return fmt.Errorf("%q was not found in the state", td.ResourceName)
+			}
+
+			client, err := buildClient()
+			if err != nil {
+				return fmt.Errorf("building client: %+v", err)
+			}
+
+			imported, err := importResource(client, td.ResourceType, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			diffs := helpers.DiffAttributes(rs.Primary.Attributes, imported.Attributes, ignore...)
+			if len(diffs) > 0 {
+				return fmt.Errorf("%d fields didn't survive an import of %q (fields which are no longer set are not flattened in the Read function):\n\n%s", len(diffs), td.ResourceName, diffsAsString(diffs))
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceSchema(resourceType string) (*schema.Resource, error) {
+	azureProvider := AzureProvider
+	if azureProvider == nil {
+		azureProvider = provider.TestAzureProvider().(*schema.Provider)
+	}
+
+	r, ok := azureProvider.ResourcesMap[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("the Resource %q was not found in the Provider", resourceType)
+	}
+
+	return r, nil
+}
+
+// refreshResource calls the Read function for the resource, returning the new state (or nil
+// if the resource has been removed from the state)
+func refreshResource(client *clients.Client, resourceType string, state *terraform.InstanceState) (*terraform.InstanceState, error) {
+	r, err := resourceSchema(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed, err := r.RefreshWithoutUpgrade(state.DeepCopy(), client)
+	if err != nil {
+		return nil, fmt.Errorf("refreshing %q (ID %q): %+v", resourceType, state.ID, err)
+	}
+	if refreshed == nil || refreshed.ID == "" {
+		return nil, nil
+	}
+
+	return refreshed, nil
+}
+
+// importResource runs the Importer and then the Read function for the resource with the specified ID
+func importResource(client *clients.Client, resourceType string, id string) (*terraform.InstanceState, error) {
+	r, err := resourceSchema(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Importer == nil {
+		return nil, fmt.Errorf("the Resource %q does not support import", resourceType)
+	}
+
+	data := r.Data(nil)
+	data.SetId(id)
+	data.SetType(resourceType)
+
+	results := []*schema.ResourceData{data}
+	if r.Importer.State != nil {
+		results, err = r.Importer.State(data, client)
+		if err != nil {
+			return nil, fmt.Errorf("importing %q (ID %q): %+v", resourceType, id, err)
+		}
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected 1 resource to be imported for %q (ID %q) but got %d", resourceType, id, len(results))
+	}
+
+	imported, err := refreshResource(client, resourceType, results[0].State())
+	if err != nil {
+		return nil, err
+	}
+	if imported == nil {
+		return nil, fmt.Errorf("%q (ID %q) was removed from the state during the import", resourceType, id)
+	}
+
+	return imported, nil
+}
+
+func fieldsAsDiffs(fields []string) []helpers.AttributeDiff {
+	output := make([]helpers.AttributeDiff, 0)
+	for _, field := range fields {
+		output = append(output, helpers.AttributeDiff{Key: field})
+	}
+	return output
+}
+
+func diffsAsString(diffs []helpers.AttributeDiff) string {
+	output := make([]string, 0)
+	for _, diff := range diffs {
+		output = append(output, fmt.Sprintf("* %s", diff.String()))
+	}
+	return strings.Join(output, "\n")
+}
@@ -0,0 +1,44 @@
+package sweepers
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Client contains the Azure SDK clients used by the Sweepers
+type Client struct {
+	// SubscriptionId is the Subscription in which leaked resources are swept
+	SubscriptionId string
+
+	GroupsClient *resources.GroupsClient
+	VaultsClient *keyvault.VaultsClient
+
+	// deletedResourcesClient is used to list and purge Soft-Deleted resources which aren't
+	// available in the version of the Azure SDK which is vendored
+	deletedResourcesClient *deletedResourcesClient
+}
+
+// NewClient returns a Client which connects to the specified Resource Manager endpoint - which
+// allows the Sweepers to be run against a fake endpoint
+func NewClient(endpoint, subscriptionId string, authorizer autorest.Authorizer) *Client {
+	groupsClient := resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
+	groupsClient.Authorizer = authorizer
+
+	vaultsClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
+	vaultsClient.Authorizer = authorizer
+
+	deletedClient := &deletedResourcesClient{
+		Client:         autorest.NewClientWithUserAgent("terraform-provider-azurerm-sweeper"),
+		BaseURI:        endpoint,
+		SubscriptionId: subscriptionId,
+	}
+	deletedClient.Authorizer = authorizer
+
+	return &Client{
+		SubscriptionId:         subscriptionId,
+		GroupsClient:           &groupsClient,
+		VaultsClient:           &vaultsClient,
+		deletedResourcesClient: deletedClient,
+	}
+}
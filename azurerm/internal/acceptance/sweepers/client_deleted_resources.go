@@ -0,0 +1,117 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// deletedResourcesClient lists and purges Soft-Deleted resources using the Resource Manager API directly,
+// since the versions of the Cognitive Services and API Management SDKs which are vendored predate Soft-Delete
+type deletedResourcesClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionId string
+}
+
+type deletedResource struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Properties *deletedResourceProperties `json:"properties,omitempty"`
+}
+
+type deletedResourceProperties struct {
+	DeletionDate *date.Time `json:"deletionDate,omitempty"`
+}
+
+type deletedResourceListResult struct {
+	autorest.Response `json:"-"`
+	Value             *[]deletedResource `json:"value,omitempty"`
+	NextLink          *string            `json:"nextLink,omitempty"`
+}
+
+// list returns all of the Soft-Deleted resources of the specified type within the Subscription,
+// for example `Microsoft.CognitiveServices/deletedAccounts`
+func (client deletedResourcesClient) list(ctx context.Context, resourceType string, apiVersion string) ([]deletedResource, error) {
+	pathParameters := map[string]interface{}{
+		"subscriptionId": autorest.Encode("path", client.SubscriptionId),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(fmt.Sprintf("/subscriptions/{subscriptionId}/providers/%s", resourceType), pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+
+	output := make([]deletedResource, 0)
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("preparing request: %+v", err)
+		}
+
+		resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+		if err != nil {
+			return nil, fmt.Errorf("sending request: %+v", err)
+		}
+
+		var result deletedResourceListResult
+		err = autorest.Respond(
+			resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&result),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, fmt.Errorf("parsing response: %+v", err)
+		}
+
+		if result.Value != nil {
+			output = append(output, *result.Value...)
+		}
+
+		if result.NextLink == nil || *result.NextLink == "" {
+			break
+		}
+
+		req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(*result.NextLink))
+	}
+
+	return output, nil
+}
+
+// purge requests that the Soft-Deleted resource with the specified ID is purged, without waiting for completion
+func (client deletedResourcesClient) purge(ctx context.Context, id string, apiVersion string) error {
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+
+	return autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		autorest.ByClosing())
+}
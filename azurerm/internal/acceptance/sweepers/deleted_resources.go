@@ -0,0 +1,69 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// DeletedCognitiveAccountSweeper purges Soft-Deleted Cognitive Services Accounts created by the Acceptance Tests
+type DeletedCognitiveAccountSweeper struct{}
+
+func (DeletedCognitiveAccountSweeper) Name() string {
+	return "DeletedCognitiveAccounts"
+}
+
+func (s DeletedCognitiveAccountSweeper) Sweep(ctx context.Context, client *Client, options Options) ([]Result, error) {
+	return sweepDeletedResources(ctx, client, options, s, "Microsoft.CognitiveServices/deletedAccounts", "2021-04-30")
+}
+
+// DeletedApiManagementSweeper purges Soft-Deleted API Management Services created by the Acceptance Tests
+type DeletedApiManagementSweeper struct{}
+
+func (DeletedApiManagementSweeper) Name() string {
+	return "DeletedApiManagementServices"
+}
+
+func (s DeletedApiManagementSweeper) Sweep(ctx context.Context, client *Client, options Options) ([]Result, error) {
+	return sweepDeletedResources(ctx, client, options, s, "Microsoft.ApiManagement/deletedservices", "2020-06-01-preview")
+}
+
+func sweepDeletedResources(ctx context.Context, client *Client, options Options, sweeper Sweeper, resourceType, apiVersion string) ([]Result, error) {
+	results := make([]Result, 0)
+
+	items, err := client.deletedResourcesClient.list(ctx, resourceType, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("listing %q: %+v", resourceType, err)
+	}
+
+	for _, item := range items {
+		if item.Name == nil || item.ID == nil {
+			continue
+		}
+		name := *item.Name
+		if !IsTestResourceName(name) {
+			continue
+		}
+
+		deletedAt, ok := CreationTimeFromName(name)
+		if !ok && item.Properties != nil && item.Properties.DeletionDate != nil {
+			deletedAt = &item.Properties.DeletionDate.Time
+		}
+		if !options.isOldEnough(deletedAt) {
+			log.Printf("[DEBUG] Skipping %q since it's not old enough", *item.ID)
+			continue
+		}
+
+		result := newResult(sweeper, *item.ID, deletedAt, options)
+		if !options.DryRun {
+			if err := client.deletedResourcesClient.purge(ctx, *item.ID, apiVersion); err != nil {
+				result.Error = fmt.Errorf("purging %q: %+v", *item.ID, err)
+			} else {
+				result.Removed = true
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, removalErrors(results)
+}
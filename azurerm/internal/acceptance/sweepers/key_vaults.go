@@ -0,0 +1,64 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// DeletedKeyVaultSweeper purges Soft-Deleted Key Vaults created by the Acceptance Tests, which otherwise
+// reserve the name (and the associated resources) until they're purged automatically
+type DeletedKeyVaultSweeper struct{}
+
+func (DeletedKeyVaultSweeper) Name() string {
+	return "DeletedKeyVaults"
+}
+
+func (s DeletedKeyVaultSweeper) Sweep(ctx context.Context, client *Client, options Options) ([]Result, error) {
+	results := make([]Result, 0)
+
+	vaults, err := client.VaultsClient.ListDeletedComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Deleted Key Vaults: %+v", err)
+	}
+
+	for vaults.NotDone() {
+		vault := vaults.Value()
+		if err := vaults.NextWithContext(ctx); err != nil {
+			return results, fmt.Errorf("listing Deleted Key Vaults: %+v", err)
+		}
+
+		if vault.Name == nil || vault.ID == nil || vault.Properties == nil || vault.Properties.Location == nil {
+			continue
+		}
+		name := *vault.Name
+		if !IsTestResourceName(name) {
+			continue
+		}
+
+		// the name is the best indicator of age, however not all Key Vaults contain a timestamp
+		deletedAt, ok := CreationTimeFromName(name)
+		if !ok && vault.Properties.DeletionDate != nil {
+			deletedAt = &vault.Properties.DeletionDate.Time
+		}
+		if !options.isOldEnough(deletedAt) {
+			log.Printf("[DEBUG] Skipping Deleted Key Vault %q since it's not old enough", name)
+			continue
+		}
+
+		location := *vault.Properties.Location
+		result := newResult(s, *vault.ID, deletedAt, options)
+		if !options.DryRun {
+			// purging can fail for an individual vault (for example when Purge Protection is enabled)
+			// so we record this and continue, to purge as many Key Vaults as possible
+			if _, err := client.VaultsClient.PurgeDeleted(ctx, name, location); err != nil {
+				result.Error = fmt.Errorf("purging Deleted Key Vault %q (Location %q): %+v", name, location, err)
+			} else {
+				result.Removed = true
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, removalErrors(results)
+}
@@ -0,0 +1,59 @@
+package sweepers
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// testResourcePrefixes are the prefixes used by the Acceptance Tests for resources which are named
+// from the `TestData`, for example `acctestRG-%d` or `vault%d`
+var testResourcePrefixes = []string{
+	"acctest",
+	"amtest",
+	"vault",
+}
+
+// randomIntegerRegex matches the value of `TestData.RandomInteger` (see `RandTimeInt`) which is of
+// the format YYMMddHHmmsshhRRRR - and which may be truncated to 16 or 17 digits by `RandomIntOfLength`
+var randomIntegerRegex = regexp.MustCompile(`[0-9]{16,18}`)
+
+// IsTestResourceName returns whether the specified name looks like one generated by the Acceptance Tests.
+//
+// Names starting with `acctest` are always considered Test Resources, however since the other prefixes
+// are more generic these must also contain an embedded timestamp, to avoid matching real resources.
+func IsTestResourceName(name string) bool {
+	lowered := strings.ToLower(name)
+	if strings.HasPrefix(lowered, "acctest") {
+		return true
+	}
+
+	for _, prefix := range testResourcePrefixes {
+		if !strings.HasPrefix(lowered, prefix) {
+			continue
+		}
+
+		if _, ok := CreationTimeFromName(name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreationTimeFromName parses the timestamp embedded within a Test Resource name by `TestData.RandomInteger`,
+// returning false if there's no timestamp within the name
+func CreationTimeFromName(name string) (*time.Time, bool) {
+	match := randomIntegerRegex.FindString(name)
+	if match == "" {
+		return nil, false
+	}
+
+	// the first 12 digits are YYMMddHHmmss, which are generated from the Local time
+	t, err := time.ParseInLocation("060102150405", match[0:12], time.Local)
+	if err != nil {
+		return nil, false
+	}
+
+	return &t, true
+}
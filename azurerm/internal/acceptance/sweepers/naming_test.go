@@ -0,0 +1,107 @@
+package sweepers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsTestResourceName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected bool
+	}{
+		{
+			name:     "acctestRG-201020151234560123",
+			expected: true,
+		},
+		{
+			name:     "acctestkv-abcde",
+			expected: true,
+		},
+		{
+			name:     "ACCTESTRG-cognitive-201020151234560123",
+			expected: true,
+		},
+		{
+			name:     "amtestRG-201020151234560123",
+			expected: true,
+		},
+		{
+			name:     "vault201020151234560123",
+			expected: true,
+		},
+		{
+			name:     "vault-production",
+			expected: false,
+		},
+		{
+			name:     "production-rg",
+			expected: false,
+		},
+		{
+			name:     "",
+			expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := IsTestResourceName(v.name); actual != v.expected {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestCreationTimeFromName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected *time.Time
+	}{
+		{
+			name:     "acctestRG-201020151234560123",
+			expected: timePointer(time.Date(2020, 10, 20, 15, 12, 34, 0, time.Local)),
+		},
+		{
+			// truncated by `RandomIntOfLength(16)`
+			name:     "acctest-2101021312345601",
+			expected: timePointer(time.Date(2021, 1, 2, 13, 12, 34, 0, time.Local)),
+		},
+		{
+			// the month is invalid, so this isn't a timestamp
+			name:     "acctest-201320151234560123",
+			expected: nil,
+		},
+		{
+			name:     "acctestkv-abcde",
+			expected: nil,
+		},
+		{
+			name:     "acctest-12345",
+			expected: nil,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, ok := CreationTimeFromName(v.name)
+		if v.expected == nil {
+			if ok {
+				t.Fatalf("Expected no timestamp but got %s", actual)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Fatalf("Expected a timestamp but didn't get one")
+		}
+		if !actual.Equal(*v.expected) {
+			t.Fatalf("Expected %s but got %s", *v.expected, *actual)
+		}
+	}
+}
+
+func timePointer(input time.Time) *time.Time {
+	return &input
+}
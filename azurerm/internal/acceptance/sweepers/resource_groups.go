@@ -0,0 +1,57 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// ResourceGroupSweeper removes Resource Groups (and the resources within them) created by the Acceptance Tests
+type ResourceGroupSweeper struct{}
+
+func (ResourceGroupSweeper) Name() string {
+	return "ResourceGroups"
+}
+
+func (s ResourceGroupSweeper) Sweep(ctx context.Context, client *Client, options Options) ([]Result, error) {
+	results := make([]Result, 0)
+
+	groups, err := client.GroupsClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Groups: %+v", err)
+	}
+
+	for groups.NotDone() {
+		group := groups.Value()
+		if err := groups.NextWithContext(ctx); err != nil {
+			return results, fmt.Errorf("listing Resource Groups: %+v", err)
+		}
+
+		if group.Name == nil || group.ID == nil {
+			continue
+		}
+		name := *group.Name
+		if !IsTestResourceName(name) {
+			continue
+		}
+
+		createdAt, _ := CreationTimeFromName(name)
+		if !options.isOldEnough(createdAt) {
+			log.Printf("[DEBUG] Skipping Resource Group %q since it's not old enough", name)
+			continue
+		}
+
+		result := newResult(s, *group.ID, createdAt, options)
+		if !options.DryRun {
+			// Resource Group deletion can take some time, so we trigger this rather than waiting for it
+			if _, err := client.GroupsClient.Delete(ctx, name); err != nil {
+				result.Error = fmt.Errorf("deleting Resource Group %q: %+v", name, err)
+			} else {
+				result.Removed = true
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, removalErrors(results)
+}
@@ -0,0 +1,180 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Options control which leaked resources are removed by the Sweepers
+type Options struct {
+	// DryRun outputs the resources which would be removed, without removing them
+	DryRun bool
+
+	// MinimumAge is the minimum age of a resource before it's considered leaked, which ensures
+	// that resources belonging to tests which are currently running aren't removed
+	MinimumAge time.Duration
+
+	// IncludeUnknownAge controls whether resources whose age cannot be determined are removed, since
+	// these may belong to tests which are currently running this defaults to false
+	IncludeUnknownAge bool
+
+	// Now returns the current time, which is used to determine the age of a resource.
+	// When unset this defaults to `time.Now`
+	Now func() time.Time
+}
+
+func (o Options) now() time.Time {
+	if o.Now != nil {
+		return o.Now()
+	}
+
+	return time.Now()
+}
+
+// isOldEnough returns whether a resource created (or deleted) at the specified time is old enough to be swept,
+// where the age of a resource cannot be determined this is controlled by `IncludeUnknownAge`
+func (o Options) isOldEnough(at *time.Time) bool {
+	if at == nil {
+		return o.IncludeUnknownAge
+	}
+
+	return o.now().Sub(*at) >= o.MinimumAge
+}
+
+// Result is the outcome of sweeping a single resource
+type Result struct {
+	// Sweeper is the name of the Sweeper which found this resource
+	Sweeper string
+
+	// ID is the Resource ID (or Name, for items which have no ID) of the leaked resource
+	ID string
+
+	// Age is how old the resource is, or nil if this cannot be determined
+	Age *time.Duration
+
+	// Removed is whether the resource was removed - which is false during a Dry Run
+	Removed bool
+
+	// Error is the error returned when removing the resource, if any
+	Error error
+}
+
+func (r Result) String() string {
+	age := "unknown age"
+	if r.Age != nil {
+		age = fmt.Sprintf("age %s", r.Age.Round(time.Minute))
+	}
+
+	if r.Error != nil {
+		return fmt.Sprintf("[%s] failed to remove %q (%s): %+v", r.Sweeper, r.ID, age, r.Error)
+	}
+
+	action := "would remove"
+	if r.Removed {
+		action = "removed"
+	}
+
+	return fmt.Sprintf("[%s] %s %q (%s)", r.Sweeper, action, r.ID, age)
+}
+
+// Sweeper lists and removes resources leaked by the Acceptance Tests for a single type of resource
+type Sweeper interface {
+	// Name is the name of this Sweeper, used for filtering and logging
+	Name() string
+
+	// Sweep lists and (unless this is a Dry Run) removes the leaked resources
+	Sweep(ctx context.Context, client *Client, options Options) ([]Result, error)
+}
+
+// All returns all of the available Sweepers.
+//
+// Resource Groups are swept first, since this places any Key Vaults (and other Soft-Delete
+// enabled resources) into a Soft-Deleted state, which are purged by a subsequent run.
+func All() []Sweeper {
+	return []Sweeper{
+		ResourceGroupSweeper{},
+		DeletedKeyVaultSweeper{},
+		DeletedCognitiveAccountSweeper{},
+		DeletedApiManagementSweeper{},
+	}
+}
+
+// Filter returns the Sweepers matching the specified names, or all Sweepers when no names are specified
+func Filter(sweepers []Sweeper, names []string) ([]Sweeper, error) {
+	if len(names) == 0 {
+		return sweepers, nil
+	}
+
+	output := make([]Sweeper, 0)
+	for _, name := range names {
+		found := false
+		for _, sweeper := range sweepers {
+			if strings.EqualFold(sweeper.Name(), strings.TrimSpace(name)) {
+				output = append(output, sweeper)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("no Sweeper exists with the name %q", name)
+		}
+	}
+
+	return output, nil
+}
+
+// Run runs each of the Sweepers in turn, continuing when a Sweeper fails so that as many
+// resources as possible are removed - returning all of the errors at the end
+func Run(ctx context.Context, client *Client, sweepers []Sweeper, options Options) ([]Result, error) {
+	results := make([]Result, 0)
+	errors := make([]string, 0)
+
+	for _, sweeper := range sweepers {
+		log.Printf("[DEBUG] Running Sweeper %q..", sweeper.Name())
+		items, err := sweeper.Sweep(ctx, client, options)
+		results = append(results, items...)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("running Sweeper %q: %+v", sweeper.Name(), err))
+		}
+	}
+
+	if len(errors) > 0 {
+		return results, fmt.Errorf("%d Sweepers failed:\n\n%s", len(errors), strings.Join(errors, "\n"))
+	}
+
+	return results, nil
+}
+
+func newResult(sweeper Sweeper, id string, at *time.Time, options Options) Result {
+	result := Result{
+		Sweeper: sweeper.Name(),
+		ID:      id,
+	}
+
+	if at != nil {
+		age := options.now().Sub(*at)
+		result.Age = &age
+	}
+
+	return result
+}
+
+// removalErrors returns an error summarising the resources which couldn't be removed, if any
+func removalErrors(results []Result) error {
+	failed := make([]string, 0)
+	for _, result := range results {
+		if result.Error != nil {
+			failed = append(failed, result.ID)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d resources couldn't be removed: %s", len(failed), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
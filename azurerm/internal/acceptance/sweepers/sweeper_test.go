@@ -0,0 +1,201 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const fakeSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+// fakeResourceManager is a fake Azure Resource Manager endpoint which returns a fixed set of resources
+// and records the requests made to remove them
+type fakeResourceManager struct {
+	lock     sync.Mutex
+	requests []string
+}
+
+func (f *fakeResourceManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	listResponses := map[string]string{
+		fmt.Sprintf("/subscriptions/%s/resourcegroups", fakeSubscriptionId): `{"value": [
+			{"id": "/subscriptions/%[1]s/resourceGroups/acctestRG-201020031234560123", "name": "acctestRG-201020031234560123", "location": "westeurope"},
+			{"id": "/subscriptions/%[1]s/resourceGroups/acctestRG-201021101234560123", "name": "acctestRG-201021101234560123", "location": "westeurope"},
+			{"id": "/subscriptions/%[1]s/resourceGroups/acctestRG-manual", "name": "acctestRG-manual", "location": "westeurope"},
+			{"id": "/subscriptions/%[1]s/resourceGroups/production", "name": "production", "location": "westeurope"}
+		]}`,
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.KeyVault/deletedVaults", fakeSubscriptionId): `{"value": [
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-protected", "name": "acctestkv-protected", "properties": {"location": "westeurope", "deletionDate": "2020-10-19T10:00:00Z"}},
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-abcde", "name": "acctestkv-abcde", "properties": {"location": "westeurope", "deletionDate": "2020-10-19T10:00:00Z"}},
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-fghij", "name": "acctestkv-fghij", "properties": {"location": "westeurope", "deletionDate": "2020-10-21T11:00:00Z"}},
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/production", "name": "production", "properties": {"location": "westeurope", "deletionDate": "2020-10-19T10:00:00Z"}}
+		]}`,
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.CognitiveServices/deletedAccounts", fakeSubscriptionId): `{"value": [
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.CognitiveServices/locations/westeurope/resourceGroups/acctestRG-201020031234560123/deletedAccounts/acctestcogacc-201020031234560123", "name": "acctestcogacc-201020031234560123", "location": "westeurope"}
+		]}`,
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.ApiManagement/deletedservices", fakeSubscriptionId): `{"value": [
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.ApiManagement/locations/westeurope/deletedservices/acctestAM-201020031234560123", "name": "acctestAM-201020031234560123", "location": "westeurope"},
+			{"id": "/subscriptions/%[1]s/providers/Microsoft.ApiManagement/locations/westeurope/deletedservices/production", "name": "production", "location": "westeurope"}
+		]}`,
+	}
+
+	if r.Method == http.MethodGet {
+		body, ok := listResponses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, body, fakeSubscriptionId)
+		return
+	}
+
+	f.lock.Lock()
+	f.requests = append(f.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	f.lock.Unlock()
+
+	// Key Vaults with Purge Protection enabled can't be purged - Azure returns a 409 here, however since
+	// the SDK retries 409's (to register Resource Providers) we return a 400 to keep the requests predictable
+	if strings.HasSuffix(r.URL.Path, "/deletedVaults/acctestkv-protected/purge") {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"code": "BadRequest", "message": "Operation 'DeleteVault' is not allowed."}}`)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeResourceManager) removalRequests() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	requests := append([]string{}, f.requests...)
+	sort.Strings(requests)
+	return requests
+}
+
+func runAgainstFakeResourceManager(options Options) ([]Result, []string, error) {
+	fake := &fakeResourceManager{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClient(server.URL, fakeSubscriptionId, autorest.NullAuthorizer{})
+	options.MinimumAge = 12 * time.Hour
+	options.Now = func() time.Time {
+		return time.Date(2020, 10, 21, 12, 0, 0, 0, time.Local)
+	}
+
+	results, err := Run(context.TODO(), client, All(), options)
+	return results, fake.removalRequests(), err
+}
+
+func TestSweepersDryRun(t *testing.T) {
+	results, requests, err := runAgainstFakeResourceManager(Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("running Sweepers: %+v", err)
+	}
+
+	if len(requests) > 0 {
+		t.Fatalf("Expected no resources to be removed during a Dry Run but got: %s", strings.Join(requests, ", "))
+	}
+
+	expected := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-201020031234560123",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-protected",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-abcde",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/westeurope/resourceGroups/acctestRG-201020031234560123/deletedAccounts/acctestcogacc-201020031234560123",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ApiManagement/locations/westeurope/deletedservices/acctestAM-201020031234560123",
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results but got %d: %+v", len(expected), len(results), results)
+	}
+	for i, v := range expected {
+		if results[i].ID != v {
+			t.Fatalf("Expected result %d to be %q but got %q", i, v, results[i].ID)
+		}
+		if results[i].Removed {
+			t.Fatalf("Expected %q not to be removed during a Dry Run", v)
+		}
+		if !strings.HasPrefix(results[i].String(), fmt.Sprintf("[%s] would remove", results[i].Sweeper)) {
+			t.Fatalf("Unexpected output for a Dry Run: %q", results[i].String())
+		}
+	}
+}
+
+func TestSweepersRemovesLeakedResources(t *testing.T) {
+	results, requests, err := runAgainstFakeResourceManager(Options{})
+	if err == nil || !strings.Contains(err.Error(), "1 Sweepers failed") {
+		t.Fatalf("Expected the Key Vault Sweeper to fail but got: %+v", err)
+	}
+
+	protectedVaultId := "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-protected"
+	for _, result := range results {
+		if result.ID == protectedVaultId {
+			if result.Removed || result.Error == nil {
+				t.Fatalf("Expected %q to fail to be removed", result.ID)
+			}
+			continue
+		}
+
+		if !result.Removed || result.Error != nil {
+			t.Fatalf("Expected %q to be removed", result.ID)
+		}
+	}
+
+	expected := []string{
+		"DELETE /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ApiManagement/locations/westeurope/deletedservices/acctestAM-201020031234560123",
+		"DELETE /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/westeurope/resourceGroups/acctestRG-201020031234560123/deletedAccounts/acctestcogacc-201020031234560123",
+		"DELETE /subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/acctestRG-201020031234560123",
+		"POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-abcde/purge",
+		"POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/locations/westeurope/deletedVaults/acctestkv-protected/purge",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("Expected the requests:\n\n%s\n\nbut got:\n\n%s", strings.Join(expected, "\n"), strings.Join(requests, "\n"))
+	}
+}
+
+func TestSweepersIncludeUnknownAge(t *testing.T) {
+	results, requests, err := runAgainstFakeResourceManager(Options{IncludeUnknownAge: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("running Sweepers: %+v", err)
+	}
+	if len(requests) > 0 {
+		t.Fatalf("Expected no resources to be removed during a Dry Run but got: %s", strings.Join(requests, ", "))
+	}
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-manual"
+	for _, result := range results {
+		if result.ID == expected {
+			if result.Age != nil {
+				t.Fatalf("Expected %q to have an unknown age but got %s", expected, *result.Age)
+			}
+			return
+		}
+	}
+
+	t.Fatalf("Expected %q to be included when `IncludeUnknownAge` is set", expected)
+}
+
+func TestFilterSweepers(t *testing.T) {
+	sweepers, err := Filter(All(), []string{"resourcegroups", " DeletedKeyVaults"})
+	if err != nil {
+		t.Fatalf("filtering Sweepers: %+v", err)
+	}
+	if len(sweepers) != 2 || sweepers[0].Name() != "ResourceGroups" || sweepers[1].Name() != "DeletedKeyVaults" {
+		t.Fatalf("Unexpected Sweepers: %+v", sweepers)
+	}
+
+	if _, err := Filter(All(), []string{"DoesNotExist"}); err == nil {
+		t.Fatalf("Expected an error when filtering to a Sweeper which doesn't exist")
+	}
+}
@@ -8,7 +8,13 @@ func Default() UserFeatures {
 			RecoverSoftDeletedKeyVaults: true,
 		},
 		Network: NetworkFeatures{
-			RelaxedLocking: false,
+			RejectShadowedSecurityRules: false,
+			RelaxedLocking:              false,
+		},
+		PreventDestroy: PreventDestroyFeatures{
+			ResourceTypes: []string{},
+			Tags:          map[string]string{},
+			AllowDestroy:  false,
 		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
@@ -6,6 +6,7 @@ type UserFeatures struct {
 	KeyVault               KeyVaultFeatures
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
+	PreventDestroy         PreventDestroyFeatures
 }
 
 type VirtualMachineFeatures struct {
@@ -23,9 +24,21 @@ type KeyVaultFeatures struct {
 }
 
 type NetworkFeatures struct {
-	RelaxedLocking bool
+	RejectShadowedSecurityRules bool
+	RelaxedLocking              bool
 }
 
 type TemplateDeploymentFeatures struct {
 	DeleteNestedItemsDuringDeletion bool
 }
+
+type PreventDestroyFeatures struct {
+	// ResourceTypes is a list of Resource Types (e.g. `azurerm_mssql_database`) which can't be deleted
+	ResourceTypes []string
+
+	// Tags is a map of Tag key/values, where resources with a matching Tag can't be deleted
+	Tags map[string]string
+
+	// AllowDestroy is an explicit override allowing protected resources to be deleted
+	AllowDestroy bool
+}
@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"regexp"
+	"sort"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -33,9 +37,43 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 			MaxItems: 1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"reject_shadowed_security_rules": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
 					"relaxed_locking": {
 						Type:     schema.TypeBool,
-						Required: true,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"prevent_destroy": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_types": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^azurerm_`), "must be the name of an `azurerm_` Resource Type"),
+						},
+					},
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"allow_destroy": {
+						Type:     schema.TypeBool,
+						Optional: true,
 					},
 				},
 			},
@@ -138,12 +176,40 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		items := raw.([]interface{})
 		if len(items) > 0 {
 			networkRaw := items[0].(map[string]interface{})
+			if v, ok := networkRaw["reject_shadowed_security_rules"]; ok {
+				features.Network.RejectShadowedSecurityRules = v.(bool)
+			}
 			if v, ok := networkRaw["relaxed_locking"]; ok {
 				features.Network.RelaxedLocking = v.(bool)
 			}
 		}
 	}
 
+	if raw, ok := val["prevent_destroy"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			preventDestroyRaw := items[0].(map[string]interface{})
+			if v, ok := preventDestroyRaw["resource_types"]; ok && v != nil {
+				resourceTypes := make([]string, 0)
+				for _, item := range v.(*schema.Set).List() {
+					resourceTypes = append(resourceTypes, item.(string))
+				}
+				sort.Strings(resourceTypes)
+				features.PreventDestroy.ResourceTypes = resourceTypes
+			}
+			if v, ok := preventDestroyRaw["tags"]; ok && v != nil {
+				tags := make(map[string]string)
+				for key, value := range v.(map[string]interface{}) {
+					tags[key] = value.(string)
+				}
+				features.PreventDestroy.Tags = tags
+			}
+			if v, ok := preventDestroyRaw["allow_destroy"]; ok {
+				features.PreventDestroy.AllowDestroy = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -25,6 +26,11 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{},
+					Tags:          map[string]string{},
+					AllowDestroy:  false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -48,7 +54,17 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"network": []interface{}{
 						map[string]interface{}{
-							"relaxed_locking": true,
+							"reject_shadowed_security_rules": true,
+							"relaxed_locking":                true,
+						},
+					},
+					"prevent_destroy": []interface{}{
+						map[string]interface{}{
+							"resource_types": schema.NewSet(schema.HashString, []interface{}{"azurerm_mssql_database"}),
+							"tags": map[string]interface{}{
+								"protected": "true",
+							},
+							"allow_destroy": true,
 						},
 					},
 					"template_deployment": []interface{}{
@@ -75,7 +91,15 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeyVaults: true,
 				},
 				Network: features.NetworkFeatures{
-					RelaxedLocking: true,
+					RejectShadowedSecurityRules: true,
+					RelaxedLocking:              true,
+				},
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{"azurerm_mssql_database"},
+					Tags: map[string]string{
+						"protected": "true",
+					},
+					AllowDestroy: true,
 				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
@@ -130,6 +154,11 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{},
+					Tags:          map[string]string{},
+					AllowDestroy:  false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -260,6 +289,24 @@ func TestExpandFeaturesNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Reject Shadowed Security Rules Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"reject_shadowed_security_rules": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					RejectShadowedSecurityRules: true,
+					RelaxedLocking:              false,
+				},
+			},
+		},
 		{
 			Name: "Relaxed Locking Disabled",
 			Input: []interface{}{
@@ -487,3 +534,82 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesPreventDestroy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{},
+					Tags:          map[string]string{},
+					AllowDestroy:  false,
+				},
+			},
+		},
+		{
+			Name: "Resource Types and Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy": []interface{}{
+						map[string]interface{}{
+							"resource_types": schema.NewSet(schema.HashString, []interface{}{"azurerm_postgresql_server", "azurerm_mssql_database"}),
+							"tags": map[string]interface{}{
+								"environment": "production",
+							},
+							"allow_destroy": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{"azurerm_mssql_database", "azurerm_postgresql_server"},
+					Tags: map[string]string{
+						"environment": "production",
+					},
+					AllowDestroy: false,
+				},
+			},
+		},
+		{
+			Name: "Allow Destroy",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy": []interface{}{
+						map[string]interface{}{
+							"resource_types": schema.NewSet(schema.HashString, []interface{}{"azurerm_mssql_database"}),
+							"tags":           map[string]interface{}{},
+							"allow_destroy":  true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroy: features.PreventDestroyFeatures{
+					ResourceTypes: []string{"azurerm_mssql_database"},
+					Tags:          map[string]string{},
+					AllowDestroy:  true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PreventDestroy, testCase.Expected.PreventDestroy) {
+			t.Fatalf("Expected %+v but got %+v", result.PreventDestroy, testCase.Expected.PreventDestroy)
+		}
+	}
+}
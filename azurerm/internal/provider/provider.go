@@ -94,6 +94,7 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			v.Delete = sdk.PreventDestroyDeleteFunc(k, v)
 			resources[k] = v
 		}
 	}
@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+// PreventDestroyDeleteFunc wraps the Delete function for the specified Resource so that
+// the Resource can't be deleted when it's protected by the `prevent_destroy` block within
+// the Provider's `features` block, unless `allow_destroy` has been set.
+//
+// This applies to both Typed and Untyped Resources and so covers both `terraform destroy`
+// and a replacement caused by a ForceNew field changing.
+func PreventDestroyDeleteFunc(resourceType string, resource *schema.Resource) schema.DeleteFunc {
+	deleteFunc := resource.Delete
+	if deleteFunc == nil {
+		return nil
+	}
+
+	_, supportsTags := resource.Schema["tags"]
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client)
+
+		tags := make(map[string]interface{})
+		if supportsTags {
+			if v, ok := d.Get("tags").(map[string]interface{}); ok {
+				tags = v
+			}
+		}
+
+		if reason := preventDestroyReason(client.Features.PreventDestroy, resourceType, tags); reason != "" {
+			return fmt.Errorf(`the %s %q is protected from deletion since %s.
+
+This is configured using the "prevent_destroy" block within the "features" block of
+the Provider - to delete this resource either remove this protection, or explicitly
+set "allow_destroy" to "true" within the "prevent_destroy" block.`, resourceType, d.Id(), reason)
+		}
+
+		return deleteFunc(d, meta)
+	}
+}
+
+// preventDestroyReason returns the reason the resource is protected from deletion
+// or an empty string if the resource can be deleted
+func preventDestroyReason(input features.PreventDestroyFeatures, resourceType string, tags map[string]interface{}) string {
+	if input.AllowDestroy {
+		return ""
+	}
+
+	for _, v := range input.ResourceTypes {
+		if v == resourceType {
+			return fmt.Sprintf("the Resource Type %q is protected", resourceType)
+		}
+	}
+
+	for key, value := range input.Tags {
+		existing, ok := tags[key]
+		if !ok {
+			continue
+		}
+
+		if existing.(string) == value {
+			return fmt.Sprintf("the Tag %q has the protected value %q", key, value)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,176 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestPreventDestroyDeleteFunc(t *testing.T) {
+	testData := []struct {
+		Name           string
+		Features       features.PreventDestroyFeatures
+		Tags           map[string]interface{}
+		ExpectDeleted  bool
+		ExpectedReason string
+	}{
+		{
+			Name:          "Not Configured",
+			Features:      features.Default().PreventDestroy,
+			ExpectDeleted: true,
+		},
+		{
+			Name: "Other Resource Type",
+			Features: features.PreventDestroyFeatures{
+				ResourceTypes: []string{"azurerm_mssql_database"},
+			},
+			ExpectDeleted: true,
+		},
+		{
+			Name: "Protected Resource Type",
+			Features: features.PreventDestroyFeatures{
+				ResourceTypes: []string{"azurerm_example"},
+			},
+			ExpectDeleted:  false,
+			ExpectedReason: `the Resource Type "azurerm_example" is protected`,
+		},
+		{
+			Name: "Protected Resource Type with Override",
+			Features: features.PreventDestroyFeatures{
+				ResourceTypes: []string{"azurerm_example"},
+				AllowDestroy:  true,
+			},
+			ExpectDeleted: true,
+		},
+		{
+			Name: "Tag with a different Value",
+			Features: features.PreventDestroyFeatures{
+				Tags: map[string]string{
+					"protected": "true",
+				},
+			},
+			Tags: map[string]interface{}{
+				"protected": "false",
+			},
+			ExpectDeleted: true,
+		},
+		{
+			Name: "Protected Tag",
+			Features: features.PreventDestroyFeatures{
+				Tags: map[string]string{
+					"protected": "true",
+				},
+			},
+			Tags: map[string]interface{}{
+				"environment": "production",
+				"protected":   "true",
+			},
+			ExpectDeleted:  false,
+			ExpectedReason: `the Tag "protected" has the protected value "true"`,
+		},
+		{
+			Name: "Protected Tag with Override",
+			Features: features.PreventDestroyFeatures{
+				Tags: map[string]string{
+					"protected": "true",
+				},
+				AllowDestroy: true,
+			},
+			Tags: map[string]interface{}{
+				"protected": "true",
+			},
+			ExpectDeleted: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		deleted := false
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				deleted = true
+				return nil
+			},
+		}
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"tags": v.Tags,
+		})
+		d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1")
+		meta := &clients.Client{
+			Features: features.UserFeatures{
+				PreventDestroy: v.Features,
+			},
+		}
+
+		err := PreventDestroyDeleteFunc("azurerm_example", resource)(d, meta)
+		if deleted != v.ExpectDeleted {
+			t.Fatalf("expected deleted to be %t but got %t", v.ExpectDeleted, deleted)
+		}
+		if v.ExpectDeleted {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !strings.Contains(err.Error(), v.ExpectedReason) {
+			t.Fatalf("expected the error to contain %q but got: %+v", v.ExpectedReason, err)
+		}
+		if !strings.Contains(err.Error(), d.Id()) {
+			t.Fatalf("expected the error to name the protected resource %q but got: %+v", d.Id(), err)
+		}
+	}
+}
+
+func TestPreventDestroyDeleteFuncWithoutTags(t *testing.T) {
+	deleted := false
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = true
+			return nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	meta := &clients.Client{
+		Features: features.UserFeatures{
+			PreventDestroy: features.PreventDestroyFeatures{
+				Tags: map[string]string{
+					"protected": "true",
+				},
+			},
+		},
+	}
+
+	if err := PreventDestroyDeleteFunc("azurerm_example", resource)(d, meta); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if !deleted {
+		t.Fatalf("expected the resource to be deleted")
+	}
+}
@@ -140,6 +140,8 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
+	resource.Delete = PreventDestroyDeleteFunc(rw.resource.ResourceType(), &resource)
+
 	// TODO: CustomizeDiff
 	// TODO: State Migrations
 
@@ -0,0 +1,236 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceGalleryApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGalleryApplicationCreateUpdate,
+		Read:   resourceGalleryApplicationRead,
+		Update: resourceGalleryApplicationCreateUpdate,
+		Delete: resourceGalleryApplicationDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.GalleryApplicationID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationName,
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedImageGalleryName,
+			},
+
+			"location": location.Schema(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"supported_os_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Linux),
+					string(compute.Windows),
+				}, false),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"end_of_life_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"eula": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"privacy_statement_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"release_note_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceGalleryApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewGalleryApplicationID(subscriptionId, d.Get("resource_group_name").(string), d.Get("gallery_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_gallery_application", id.ID())
+		}
+	}
+
+	application := compute.GalleryApplication{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		GalleryApplicationProperties: &compute.GalleryApplicationProperties{
+			SupportedOSType: compute.OperatingSystemTypes(d.Get("supported_os_type").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		application.GalleryApplicationProperties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, _ := time.Parse(time.RFC3339, v.(string))
+		application.GalleryApplicationProperties.EndOfLifeDate = &date.Time{
+			Time: endOfLifeDate,
+		}
+	}
+
+	if v, ok := d.GetOk("eula"); ok {
+		application.GalleryApplicationProperties.Eula = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("privacy_statement_uri"); ok {
+		application.GalleryApplicationProperties.PrivacyStatementURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("release_note_uri"); ok {
+		application.GalleryApplicationProperties.ReleaseNoteURI = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, application)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGalleryApplicationRead(d, meta)
+}
+
+func resourceGalleryApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ApplicationName)
+	d.Set("gallery_name", id.GalleryName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.GalleryApplicationProperties; props != nil {
+		d.Set("description", props.Description)
+
+		endOfLifeDate := ""
+		if props.EndOfLifeDate != nil {
+			endOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+		}
+		d.Set("end_of_life_date", endOfLifeDate)
+
+		d.Set("eula", props.Eula)
+		d.Set("privacy_statement_uri", props.PrivacyStatementURI)
+		d.Set("release_note_uri", props.ReleaseNoteURI)
+		d.Set("supported_os_type", string(props.SupportedOSType))
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceGalleryApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
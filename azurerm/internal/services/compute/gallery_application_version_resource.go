@@ -0,0 +1,363 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceGalleryApplicationVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGalleryApplicationVersionCreateUpdate,
+		Read:   resourceGalleryApplicationVersionRead,
+		Update: resourceGalleryApplicationVersionCreateUpdate,
+		Delete: resourceGalleryApplicationVersionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.GalleryApplicationVersionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationVersionName,
+			},
+
+			"application_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationName,
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedImageGalleryName,
+			},
+
+			"location": location.Schema(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"media_link": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+
+			"target_region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"regional_replica_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+
+						// NOTE: as with the `azurerm_shared_image_version` resource, this can't be updated
+						// but can't be ForceNew since that'd recreate the resource when a region is added/removed
+						"storage_account_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.StorageAccountTypePremiumLRS),
+								string(compute.StorageAccountTypeStandardLRS),
+								string(compute.StorageAccountTypeStandardZRS),
+							}, false),
+							Default: string(compute.StorageAccountTypeStandardLRS),
+						},
+					},
+				},
+			},
+
+			"content_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enable_health_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"end_of_life_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"exclude_from_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceGalleryApplicationVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewGalleryApplicationVersionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("gallery_name").(string), d.Get("application_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_gallery_application_version", id.ID())
+		}
+	}
+
+	version := compute.GalleryApplicationVersion{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		GalleryApplicationVersionProperties: &compute.GalleryApplicationVersionProperties{
+			PublishingProfile: &compute.GalleryApplicationVersionPublishingProfile{
+				EnableHealthCheck: utils.Bool(d.Get("enable_health_check").(bool)),
+				ExcludeFromLatest: utils.Bool(d.Get("exclude_from_latest").(bool)),
+				Source:            expandGalleryApplicationVersionSource(d.Get("source").([]interface{})),
+				TargetRegions:     expandSharedImageVersionTargetRegions(d),
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		version.GalleryApplicationVersionProperties.PublishingProfile.ContentType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, _ := time.Parse(time.RFC3339, v.(string))
+		version.GalleryApplicationVersionProperties.PublishingProfile.EndOfLifeDate = &date.Time{
+			Time: endOfLifeDate,
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, version)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGalleryApplicationVersionRead(d, meta)
+}
+
+func resourceGalleryApplicationVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VersionName)
+	d.Set("application_name", id.ApplicationName)
+	d.Set("gallery_name", id.GalleryName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.GalleryApplicationVersionProperties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			d.Set("content_type", profile.ContentType)
+
+			enableHealthCheck := false
+			if profile.EnableHealthCheck != nil {
+				enableHealthCheck = *profile.EnableHealthCheck
+			}
+			d.Set("enable_health_check", enableHealthCheck)
+
+			endOfLifeDate := ""
+			if profile.EndOfLifeDate != nil {
+				endOfLifeDate = profile.EndOfLifeDate.Format(time.RFC3339)
+			}
+			d.Set("end_of_life_date", endOfLifeDate)
+
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			if err := d.Set("source", flattenGalleryApplicationVersionSource(profile.Source)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+
+			if err := d.Set("target_region", flattenSharedImageVersionTargetRegions(profile.TargetRegions)); err != nil {
+				return fmt.Errorf("setting `target_region`: %+v", err)
+			}
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceGalleryApplicationVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	// as with Shared Image Versions the replicas can take a while to be removed, which blocks
+	// the deletion of the parent Gallery Application - so we need to poll until this is gone
+	timeout, _ := ctx.Deadline()
+	log.Printf("[DEBUG] Waiting for %s to be eventually deleted", *id)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   galleryApplicationVersionDeleteStateRefreshFunc(ctx, client, *id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 10,
+		Timeout:                   time.Until(timeout),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func galleryApplicationVersionDeleteStateRefreshFunc(ctx context.Context, client *compute.GalleryApplicationVersionsClient, id parse.GalleryApplicationVersionId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(res.Response) {
+				return "NotFound", "NotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("polling to check if %s has been deleted: %+v", id, err)
+		}
+
+		return res, "Exists", nil
+	}
+}
+
+func expandGalleryApplicationVersionSource(input []interface{}) *compute.UserArtifactSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.UserArtifactSource{
+		FileName:  utils.String(raw["file_name"].(string)),
+		MediaLink: utils.String(raw["media_link"].(string)),
+	}
+}
+
+func flattenGalleryApplicationVersionSource(input *compute.UserArtifactSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	fileName := ""
+	if input.FileName != nil {
+		fileName = *input.FileName
+	}
+
+	mediaLink := ""
+	if input.MediaLink != nil {
+		mediaLink = *input.MediaLink
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"file_name":  fileName,
+			"media_link": mediaLink,
+		},
+	}
+}
@@ -209,6 +209,10 @@ func resourceLinuxVirtualMachineScaleSet() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			// this only controls how the provider rolls the instances and isn't returned by the API, so it's never set in the Read
+			//lintignore:resourcereadset
+			"rolling_upgrade_orchestration": VirtualMachineScaleSetRollingUpgradeOrchestrationSchema(),
+
 			"rolling_upgrade_policy": VirtualMachineScaleSetRollingUpgradePolicySchema(),
 
 			"secret": linuxSecretSchema(),
@@ -273,7 +277,17 @@ func resourceLinuxVirtualMachineScaleSet() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceLinuxVirtualMachineScaleSetCustomizeDiff,
+	}
+}
+
+func resourceLinuxVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("upgrade_mode") || !d.NewValueKnown("rolling_upgrade_orchestration") {
+		return nil
 	}
+
+	return ValidateVirtualMachineScaleSetRollingUpgradeOrchestration(compute.UpgradeMode(d.Get("upgrade_mode").(string)), d.Get("rolling_upgrade_orchestration").([]interface{}))
 }
 
 func resourceLinuxVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
@@ -821,6 +835,7 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta inte
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
+		RollingUpgradeOrchestration:  ExpandVirtualMachineScaleSetRollingUpgradeOrchestration(d.Get("rolling_upgrade_orchestration").([]interface{})),
 		UpdateInstances:              updateInstances,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
@@ -199,6 +199,35 @@ func TestAccLinuxVirtualMachineScaleSet_scalingUpdateSku(t *testing.T) {
 	})
 }
 
+func TestAccLinuxVirtualMachineScaleSet_scalingUpdateSkuRollingUpgradeOrchestration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine_scale_set", "test")
+	r := LinuxVirtualMachineScaleSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.scalingUpdateSkuRollingUpgradeOrchestration(data, "Standard_F2"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(
+			"admin_password",
+			"rolling_upgrade_orchestration",
+		),
+		{
+			// rolls the instances in batches of one, pausing between each batch
+			Config: r.scalingUpdateSkuRollingUpgradeOrchestration(data, "Standard_F4"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(
+			"admin_password",
+			"rolling_upgrade_orchestration",
+		),
+	})
+}
+
 func TestAccLinuxVirtualMachineScaleSet_scalingZonesSingle(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine_scale_set", "test")
 	r := LinuxVirtualMachineScaleSetResource{}
@@ -608,6 +637,53 @@ resource "azurerm_linux_virtual_machine_scale_set" "test" {
 `, r.template(data), data.RandomInteger, skuName)
 }
 
+func (r LinuxVirtualMachineScaleSetResource) scalingUpdateSkuRollingUpgradeOrchestration(data acceptance.TestData, skuName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                = "acctestvmss-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = %q
+  instances           = 3
+  admin_username      = "adminuser"
+  admin_password      = "P@ssword1234!"
+
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  rolling_upgrade_orchestration {
+    batch_instance_percent                  = 33
+    max_unhealthy_upgraded_instance_percent = 0
+    pause_time_between_batches              = "PT30S"
+  }
+}
+`, r.template(data), data.RandomInteger, skuName)
+}
+
 func (r LinuxVirtualMachineScaleSetResource) scalingUpdateSkuIgnoredUpdatedCount(data acceptance.TestData, skuName string) string {
 	return fmt.Sprintf(`
 %s
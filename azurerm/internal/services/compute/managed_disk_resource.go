@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -143,6 +144,16 @@ func resourceManagedDisk() *schema.Resource {
 
 			"encryption_settings": encryptionSettingsSchema(),
 
+			"update_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  managedDiskUpdateStrategyDeallocate,
+				ValidateFunc: validation.StringInSlice([]string{
+					managedDiskUpdateStrategyDeallocate,
+					managedDiskUpdateStrategyOnlineIfPossible,
+				}, false),
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -300,6 +311,9 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := d.Get("resource_group_name").(string)
 	storageAccountType := d.Get("storage_account_type").(string)
 	shouldShutDown := false
+	change := managedDiskChange{
+		storageAccountType: storageAccountType,
+	}
 
 	disk, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -321,6 +335,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("storage_account_type") {
 		shouldShutDown = true
+		change.skuChanged = true
 		var skuName compute.DiskStorageAccountTypes
 		for _, v := range compute.PossibleDiskStorageAccountTypesValues() {
 			if strings.EqualFold(storageAccountType, string(v)) {
@@ -355,6 +370,8 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("disk_size_gb") {
 		if old, new := d.GetChange("disk_size_gb"); new.(int) > old.(int) {
 			shouldShutDown = true
+			change.oldSizeGB = old.(int)
+			change.newSizeGB = new.(int)
 			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(new.(int)))
 		} else {
 			return fmt.Errorf("Error - New size must be greater than original size. Shrinking disks is not supported on Azure")
@@ -363,6 +380,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("disk_encryption_set_id") {
 		shouldShutDown = true
+		change.encryptionChanged = true
 		if diskEncryptionSetId := d.Get("disk_encryption_set_id").(string); diskEncryptionSetId != "" {
 			diskUpdate.Encryption = &compute.Encryption{
 				Type:                compute.EncryptionAtRestWithCustomerKey,
@@ -373,6 +391,25 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// some changes (e.g. expanding a Data Disk) can be made whilst the Virtual Machine is running
+	if shouldShutDown && d.Get("update_strategy").(string) == managedDiskUpdateStrategyOnlineIfPossible {
+		if props := disk.DiskProperties; props != nil && props.MaxShares != nil {
+			change.maxShares = int(*props.MaxShares)
+		}
+
+		// the `os_type` can also be set on Data Disks, so we check how the disk is attached to the Virtual Machine
+		if disk.ManagedBy != nil && disk.ID != nil {
+			isOSDisk, err := managedDiskIsAttachedAsOSDisk(ctx, meta.(*clients.Client).Compute.VMClient, *disk.ManagedBy, *disk.ID)
+			if err != nil {
+				return err
+			}
+			change.isOSDisk = isOSDisk
+		}
+
+		shouldShutDown = managedDiskChangeRequiresDeallocation(change)
+		log.Printf("[DEBUG] Managed Disk %q (Resource Group %q) requires the attached Virtual Machine to be deallocated: %t", name, resourceGroup, shouldShutDown)
+	}
+
 	// whilst we need to shut this down, if we're not attached to anything there's no point
 	if shouldShutDown && disk.ManagedBy == nil {
 		shouldShutDown = false
@@ -556,6 +593,13 @@ func resourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// `update_strategy` only affects how changes are applied, so it isn't returned by the API
+	updateStrategy := managedDiskUpdateStrategyDeallocate
+	if v, ok := d.GetOk("update_strategy"); ok {
+		updateStrategy = v.(string)
+	}
+	d.Set("update_strategy", updateStrategy)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -580,3 +624,63 @@ func resourceManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+const (
+	managedDiskUpdateStrategyDeallocate       = "Deallocate"
+	managedDiskUpdateStrategyOnlineIfPossible = "OnlineIfPossible"
+
+	// Disks of 4 TiB or smaller can't be expanded beyond 4 TiB without deallocating the Virtual Machine
+	managedDiskOnlineExpansionThresholdGB = 4096
+)
+
+type managedDiskChange struct {
+	storageAccountType string
+	skuChanged         bool
+	encryptionChanged  bool
+	oldSizeGB          int
+	newSizeGB          int
+	isOSDisk           bool
+	maxShares          int
+}
+
+// managedDiskChangeRequiresDeallocation determines whether the Virtual Machine the Managed Disk is
+// attached to must be deallocated for this change to be applied
+func managedDiskChangeRequiresDeallocation(input managedDiskChange) bool {
+	if input.skuChanged || input.encryptionChanged {
+		return true
+	}
+
+	if input.newSizeGB > input.oldSizeGB {
+		// only Data Disks which aren't Shared or Ultra Disks can be expanded whilst attached
+		if input.isOSDisk || input.maxShares > 1 || strings.EqualFold(input.storageAccountType, string(compute.UltraSSDLRS)) {
+			return true
+		}
+
+		if input.oldSizeGB <= managedDiskOnlineExpansionThresholdGB && input.newSizeGB > managedDiskOnlineExpansionThresholdGB {
+			return true
+		}
+	}
+
+	return false
+}
+
+// managedDiskIsAttachedAsOSDisk determines whether the Managed Disk is the OS Disk of the Virtual Machine it's attached to
+func managedDiskIsAttachedAsOSDisk(ctx context.Context, client *compute.VirtualMachinesClient, virtualMachineId, diskId string) (bool, error) {
+	id, err := parse.VirtualMachineID(virtualMachineId)
+	if err != nil {
+		return false, fmt.Errorf("parsing Virtual Machine ID %q: %+v", virtualMachineId, err)
+	}
+
+	vm, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return false, fmt.Errorf("retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+		if osDisk := props.StorageProfile.OsDisk; osDisk != nil && osDisk.ManagedDisk != nil && osDisk.ManagedDisk.ID != nil {
+			return strings.EqualFold(*osDisk.ManagedDisk.ID, diskId), nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,115 @@
+package compute
+
+import "testing"
+
+func TestManagedDiskChangeRequiresDeallocation(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    managedDiskChange
+		Expected bool
+	}{
+		{
+			Name:     "no changes",
+			Input:    managedDiskChange{storageAccountType: "Premium_LRS"},
+			Expected: false,
+		},
+		{
+			Name: "sku changed",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				skuChanged:         true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "disk encryption set changed",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				encryptionChanged:  true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "expand data disk",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          128,
+				newSizeGB:          256,
+			},
+			Expected: false,
+		},
+		{
+			Name: "expand data disk above 4TiB",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          1024,
+				newSizeGB:          8192,
+			},
+			Expected: true,
+		},
+		{
+			Name: "expand data disk to 4TiB",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          1024,
+				newSizeGB:          4096,
+			},
+			Expected: false,
+		},
+		{
+			Name: "expand data disk from 4TiB",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          4096,
+				newSizeGB:          8192,
+			},
+			Expected: true,
+		},
+		{
+			Name: "expand data disk already above 4TiB",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          8192,
+				newSizeGB:          16384,
+			},
+			Expected: false,
+		},
+		{
+			Name: "expand os disk",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          30,
+				newSizeGB:          64,
+				isOSDisk:           true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "expand shared disk",
+			Input: managedDiskChange{
+				storageAccountType: "Premium_LRS",
+				oldSizeGB:          256,
+				newSizeGB:          512,
+				maxShares:          2,
+			},
+			Expected: true,
+		},
+		{
+			Name: "expand ultra disk",
+			Input: managedDiskChange{
+				storageAccountType: "UltraSSD_LRS",
+				oldSizeGB:          256,
+				newSizeGB:          512,
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := managedDiskChangeRequiresDeallocation(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -300,6 +300,30 @@ func TestAccManagedDisk_attachedDiskUpdate(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_attachedDiskUpdateOnline(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.managedDiskAttachedOnline(data, 10),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("update_strategy").HasValue("OnlineIfPossible"),
+			),
+		},
+		data.ImportStep("update_strategy"),
+		{
+			Config: r.managedDiskAttachedOnline(data, 20),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disk_size_gb").HasValue("20"),
+			),
+		},
+		data.ImportStep("update_strategy"),
+	})
+}
+
 func TestAccManagedDisk_attachedStorageTypeUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -945,6 +969,33 @@ resource "azurerm_virtual_machine_data_disk_attachment" "test" {
 `, r.templateAttached(data), data.RandomInteger, diskSize)
 }
 
+func (r ManagedDiskResource) managedDiskAttachedOnline(data acceptance.TestData, diskSize int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "%d-disk1"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = %d
+  update_strategy      = "OnlineIfPossible"
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  lun                = "0"
+  caching            = "None"
+}
+`, r.templateAttached(data), data.RandomInteger, diskSize)
+}
+
 func (r ManagedDiskResource) storageTypeUpdateWhilstAttached(data acceptance.TestData, storageAccountType string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
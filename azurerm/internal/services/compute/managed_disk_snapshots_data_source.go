@@ -0,0 +1,228 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceManagedDiskSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedDiskSnapshotsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"managed_disk_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"incremental_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagedDiskSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.SnapshotsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	diskId, err := parse.ManagedDiskID(d.Get("managed_disk_id").(string))
+	if err != nil {
+		return err
+	}
+
+	disksClient := meta.(*clients.Client).Compute.DisksClient
+	disk, err := disksClient.Get(ctx, diskId.ResourceGroup, diskId.DiskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(disk.Response) {
+			return fmt.Errorf("%s was not found", *diskId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *diskId, err)
+	}
+
+	diskUniqueId := ""
+	if props := disk.DiskProperties; props != nil && props.UniqueID != nil {
+		diskUniqueId = *props.UniqueID
+	}
+
+	snapshots := make([]compute.Snapshot, 0)
+	if resourceGroup := d.Get("resource_group_name").(string); resourceGroup != "" {
+		iterator, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
+		if err != nil {
+			return fmt.Errorf("listing Snapshots in Resource Group %q: %+v", resourceGroup, err)
+		}
+		for iterator.NotDone() {
+			snapshots = append(snapshots, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Snapshots in Resource Group %q: %+v", resourceGroup, err)
+			}
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			return fmt.Errorf("listing Snapshots: %+v", err)
+		}
+		for iterator.NotDone() {
+			snapshots = append(snapshots, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Snapshots: %+v", err)
+			}
+		}
+	}
+
+	output := make([]interface{}, 0)
+	for _, snapshot := range filterSnapshotsForManagedDisk(snapshots, diskId.ID(), diskUniqueId) {
+		id, err := parse.SnapshotID(*snapshot.ID)
+		if err != nil {
+			return err
+		}
+
+		loc := ""
+		if snapshot.Location != nil {
+			loc = location.Normalize(*snapshot.Location)
+		}
+
+		diskSizeGB := 0
+		incrementalEnabled := false
+		timeCreated := ""
+		if props := snapshot.SnapshotProperties; props != nil {
+			if props.DiskSizeGB != nil {
+				diskSizeGB = int(*props.DiskSizeGB)
+			}
+			if props.Incremental != nil {
+				incrementalEnabled = *props.Incremental
+			}
+			if props.TimeCreated != nil {
+				timeCreated = props.TimeCreated.Format(time.RFC3339)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                  id.ID(),
+			"name":                id.Name,
+			"resource_group_name": id.ResourceGroup,
+			"location":            loc,
+			"disk_size_gb":        diskSizeGB,
+			"incremental_enabled": incrementalEnabled,
+			"time_created":        timeCreated,
+		})
+	}
+
+	d.SetId(diskId.ID())
+	d.Set("managed_disk_id", diskId.ID())
+
+	if err := d.Set("snapshots", output); err != nil {
+		return fmt.Errorf("setting `snapshots`: %+v", err)
+	}
+
+	return nil
+}
+
+// filterSnapshotsForManagedDisk returns the Snapshots created from the specified Managed Disk,
+// ordered from the oldest to the newest
+func filterSnapshotsForManagedDisk(input []compute.Snapshot, diskId, diskUniqueId string) []compute.Snapshot {
+	output := make([]compute.Snapshot, 0)
+	for _, v := range input {
+		if v.ID == nil || v.SnapshotProperties == nil || v.SnapshotProperties.CreationData == nil {
+			continue
+		}
+
+		if !snapshotCreatedFromManagedDisk(*v.SnapshotProperties.CreationData, diskId, diskUniqueId) {
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return snapshotTimeCreated(output[i]).Before(snapshotTimeCreated(output[j]))
+	})
+
+	return output
+}
+
+func snapshotTimeCreated(input compute.Snapshot) time.Time {
+	if input.SnapshotProperties == nil || input.SnapshotProperties.TimeCreated == nil {
+		return time.Time{}
+	}
+
+	return input.SnapshotProperties.TimeCreated.Time
+}
+
+// snapshotCreatedFromManagedDisk determines if the Snapshot was created from the Managed Disk - the Unique ID
+// is used where available since it distinguishes between Disks which have been recreated with the same name
+func snapshotCreatedFromManagedDisk(input compute.CreationData, diskId, diskUniqueId string) bool {
+	if diskUniqueId != "" && input.SourceUniqueID != nil && *input.SourceUniqueID != "" {
+		return strings.EqualFold(*input.SourceUniqueID, diskUniqueId)
+	}
+
+	// the Managed Disk ID can be specified as either the Source Resource ID or the Source URI
+	for _, source := range []*string{input.SourceResourceID, input.SourceURI} {
+		if source != nil && strings.EqualFold(*source, diskId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFilterSnapshotsForManagedDisk(t *testing.T) {
+	diskId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1"
+	diskUniqueId := "00000000-0000-0000-0000-000000000001"
+	otherDiskId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk2"
+
+	snapshot := func(name, sourceId string, created *time.Time) compute.Snapshot {
+		props := &compute.SnapshotProperties{
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.Copy,
+				SourceResourceID: utils.String(sourceId),
+			},
+		}
+		if sourceId == diskId {
+			props.CreationData.SourceUniqueID = utils.String(diskUniqueId)
+		}
+		if created != nil {
+			props.TimeCreated = &date.Time{Time: *created}
+		}
+
+		return compute.Snapshot{
+			ID:                 utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/" + name),
+			Name:               utils.String(name),
+			SnapshotProperties: props,
+		}
+	}
+	at := func(hour int) *time.Time {
+		v := time.Date(2020, 11, 1, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	input := []compute.Snapshot{
+		snapshot("third", diskId, at(12)),
+		snapshot("other", otherDiskId, at(1)),
+		snapshot("first", diskId, at(2)),
+		snapshot("second", "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/DISK1", at(6)),
+		snapshot("unknown", diskId, nil),
+		{
+			// created from the Managed Disk ID specified as the Source URI
+			ID:   utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/fromUri"),
+			Name: utils.String("fromUri"),
+			SnapshotProperties: &compute.SnapshotProperties{
+				CreationData: &compute.CreationData{
+					CreateOption: compute.Copy,
+					SourceURI:    utils.String(diskId),
+				},
+				TimeCreated: &date.Time{Time: *at(9)},
+			},
+		},
+		{
+			// created from a previous Managed Disk with the same name
+			ID:   utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/recreated"),
+			Name: utils.String("recreated"),
+			SnapshotProperties: &compute.SnapshotProperties{
+				CreationData: &compute.CreationData{
+					CreateOption:     compute.Copy,
+					SourceResourceID: utils.String(diskId),
+					SourceUniqueID:   utils.String("00000000-0000-0000-0000-000000000002"),
+				},
+				TimeCreated: &date.Time{Time: *at(3)},
+			},
+		},
+		{
+			// imported from a blob, so there's no source resource
+			ID:   utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/imported"),
+			Name: utils.String("imported"),
+			SnapshotProperties: &compute.SnapshotProperties{
+				CreationData: &compute.CreationData{
+					CreateOption: compute.Import,
+					SourceURI:    utils.String("https://example.blob.core.windows.net/vhds/disk1.vhd"),
+				},
+			},
+		},
+	}
+
+	actual := make([]string, 0)
+	for _, v := range filterSnapshotsForManagedDisk(input, diskId, diskUniqueId) {
+		actual = append(actual, *v.Name)
+	}
+
+	expected := []string{"unknown", "first", "second", "fromUri", "third"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,80 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ManagedDiskSnapshotsDataSource struct {
+}
+
+func TestAccDataSourceManagedDiskSnapshots_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_managed_disk_snapshots", "test")
+	r := ManagedDiskSnapshotsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("2"),
+				check.That(data.ResourceName).Key("snapshots.0.name").HasValue(fmt.Sprintf("acctestss1_%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("snapshots.0.incremental_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("snapshots.1.name").HasValue(fmt.Sprintf("acctestss2_%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("snapshots.1.time_created").Exists(),
+			),
+		},
+	})
+}
+
+func (ManagedDiskSnapshotsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "first" {
+  name                = "acctestss1_%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  create_option       = "Copy"
+  source_resource_id  = azurerm_managed_disk.test.id
+  incremental_enabled = true
+}
+
+resource "azurerm_snapshot" "second" {
+  name                = "acctestss2_%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  create_option       = "Copy"
+  source_resource_id  = azurerm_managed_disk.test.id
+  incremental_enabled = true
+
+  depends_on = [azurerm_snapshot.first]
+}
+
+data "azurerm_managed_disk_snapshots" "test" {
+  managed_disk_id     = azurerm_managed_disk.test.id
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_snapshot.second]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -1,10 +1,12 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -100,21 +102,9 @@ func resourceMarketplaceAgreementCreateUpdate(d *schema.ResourceData, meta inter
 		return tf.ImportAsExistsError("azurerm_marketplace_agreement", *agreement.ID)
 	}
 
-	terms, err := client.Get(ctx, publisher, offer, plan)
-	if err != nil {
-		return fmt.Errorf("Error retrieving the Marketplace Terms for Publisher %q / Offer %q / Plan %q: %s", publisher, offer, plan, err)
-	}
-	if terms.AgreementProperties == nil {
-		return fmt.Errorf("Error retrieving the Marketplace Terms for Publisher %q / Offer %q / Plan %q: AgreementProperties was nil", publisher, offer, plan)
-	}
-
-	terms.AgreementProperties.Accepted = utils.Bool(true)
-
-	log.Printf("[DEBUG] Accepting the Marketplace Terms for Publisher %q / Offer %q / Plan %q", publisher, offer, plan)
-	if _, err := client.Create(ctx, publisher, offer, plan, terms); err != nil {
-		return fmt.Errorf("Error accepting Terms for Publisher %q / Offer %q / Plan %q: %s", publisher, offer, plan, err)
+	if err := acceptMarketplaceAgreement(ctx, client, publisher, offer, plan); err != nil {
+		return err
 	}
-	log.Printf("[DEBUG] Accepted the Marketplace Terms for Publisher %q / Offer %q / Plan %q", publisher, offer, plan)
 
 	agreement, err := client.GetAgreement(ctx, publisher, offer, plan)
 	if err != nil {
@@ -187,3 +177,24 @@ func resourceMarketplaceAgreementDelete(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+// acceptMarketplaceAgreement accepts the Marketplace Terms for the specified Publisher / Offer / Plan
+func acceptMarketplaceAgreement(ctx context.Context, client *marketplaceordering.MarketplaceAgreementsClient, publisher, offer, plan string) error {
+	terms, err := client.Get(ctx, publisher, offer, plan)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Marketplace Terms for Publisher %q / Offer %q / Plan %q: %s", publisher, offer, plan, err)
+	}
+	if terms.AgreementProperties == nil {
+		return fmt.Errorf("Error retrieving the Marketplace Terms for Publisher %q / Offer %q / Plan %q: AgreementProperties was nil", publisher, offer, plan)
+	}
+
+	terms.AgreementProperties.Accepted = utils.Bool(true)
+
+	log.Printf("[DEBUG] Accepting the Marketplace Terms for Publisher %q / Offer %q / Plan %q", publisher, offer, plan)
+	if _, err := client.Create(ctx, publisher, offer, plan, terms); err != nil {
+		return fmt.Errorf("Error accepting Terms for Publisher %q / Offer %q / Plan %q: %s", publisher, offer, plan, err)
+	}
+	log.Printf("[DEBUG] Accepted the Marketplace Terms for Publisher %q / Offer %q / Plan %q", publisher, offer, plan)
+
+	return nil
+}
@@ -123,6 +123,34 @@ func retrieveIPAddressesForNIC(ctx context.Context, nicClient *network.Interface
 	}
 }
 
+// retrievePrivateIPAddressesForScaleSetInstance returns the Private IP Addresses assigned to the
+// Network Interfaces of an Instance within a Virtual Machine Scale Set
+func retrievePrivateIPAddressesForScaleSetInstance(ctx context.Context, nicClient *network.InterfacesClient, resourceGroup, scaleSetName, instanceId string) ([]string, error) {
+	privateIPAddresses := make([]string, 0)
+
+	iterator, err := nicClient.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, resourceGroup, scaleSetName, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for iterator.NotDone() {
+		nic := iterator.Value()
+		if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if configProps := config.InterfaceIPConfigurationPropertiesFormat; configProps != nil && configProps.PrivateIPAddress != nil {
+					privateIPAddresses = append(privateIPAddresses, *configProps.PrivateIPAddress)
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return privateIPAddresses, nil
+}
+
 // retrievePublicIPAddress returns the Public IP Address associated with an Azure Public IP
 // nolint: deadcode unused
 func retrievePublicIPAddress(ctx context.Context, client *network.PublicIPAddressesClient, publicIPAddressID string) (*string, error) {
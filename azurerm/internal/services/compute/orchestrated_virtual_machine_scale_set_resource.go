@@ -52,6 +52,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *schema.Resource {
 
 			"location": azure.SchemaLocation(),
 
+			"identity": VirtualMachineScaleSetIdentitySchema(),
+
 			"platform_fault_domain_count": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -110,8 +112,17 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *schema.ResourceDa
 		}
 	}
 
+	identity, err := ExpandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	// TODO: support the OS/Storage/Network Profiles, Extensions and Instance Count once the Compute SDK is upgraded.
+	// In API Version 2020-06-01 a Scale Set is Orchestrated only when it has no `virtualMachineProfile` - sending
+	// one here would create a Uniform Scale Set instead, since `orchestrationMode` is only available from 2021-03-01.
 	props := compute.VirtualMachineScaleSet{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Identity: identity,
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
@@ -173,6 +184,14 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *schema.ResourceData, meta
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
+	identity, err := FlattenVirtualMachineScaleSetIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
 	if props := resp.VirtualMachineScaleSetProperties; props != nil {
 		d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
 		d.Set("single_placement_group", props.SinglePlacementGroup)
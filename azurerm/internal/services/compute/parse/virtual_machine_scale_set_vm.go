@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type VirtualMachineScaleSetVMId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	VirtualMachineName         string
+}
+
+func NewVirtualMachineScaleSetVMID(subscriptionId, resourceGroup, virtualMachineScaleSetName, virtualMachineName string) VirtualMachineScaleSetVMId {
+	return VirtualMachineScaleSetVMId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		VirtualMachineName:         virtualMachineName,
+	}
+}
+
+func (id VirtualMachineScaleSetVMId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Machine Name %q", id.VirtualMachineName),
+		fmt.Sprintf("Virtual Machine Scale Set Name %q", id.VirtualMachineScaleSetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Scale Set V M", segmentsStr)
+}
+
+func (id VirtualMachineScaleSetVMId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/virtualMachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName)
+}
+
+// VirtualMachineScaleSetVMID parses a VirtualMachineScaleSetVM ID into an VirtualMachineScaleSetVMId struct
+func VirtualMachineScaleSetVMID(input string) (*VirtualMachineScaleSetVMId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineScaleSetVMId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
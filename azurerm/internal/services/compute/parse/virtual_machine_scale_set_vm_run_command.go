@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type VirtualMachineScaleSetVMRunCommandId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	VirtualMachineName         string
+	RunCommandName             string
+}
+
+func NewVirtualMachineScaleSetVMRunCommandID(subscriptionId, resourceGroup, virtualMachineScaleSetName, virtualMachineName, runCommandName string) VirtualMachineScaleSetVMRunCommandId {
+	return VirtualMachineScaleSetVMRunCommandId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		VirtualMachineName:         virtualMachineName,
+		RunCommandName:             runCommandName,
+	}
+}
+
+func (id VirtualMachineScaleSetVMRunCommandId) String() string {
+	segments := []string{
+		fmt.Sprintf("Run Command Name %q", id.RunCommandName),
+		fmt.Sprintf("Virtual Machine Name %q", id.VirtualMachineName),
+		fmt.Sprintf("Virtual Machine Scale Set Name %q", id.VirtualMachineScaleSetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Scale Set V M Run Command", segmentsStr)
+}
+
+func (id VirtualMachineScaleSetVMRunCommandId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/virtualMachines/%s/runCommands/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName)
+}
+
+// VirtualMachineScaleSetVMRunCommandID parses a VirtualMachineScaleSetVMRunCommand ID into an VirtualMachineScaleSetVMRunCommandId struct
+func VirtualMachineScaleSetVMRunCommandID(input string) (*VirtualMachineScaleSetVMRunCommandId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineScaleSetVMRunCommandId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+	if resourceId.RunCommandName, err = id.PopSegment("runCommands"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
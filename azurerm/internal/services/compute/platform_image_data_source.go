@@ -2,6 +2,7 @@ package compute
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
@@ -39,9 +40,87 @@ func dataSourcePlatformImage() *schema.Resource {
 			},
 
 			"version": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_constraint"},
+			},
+
+			"version_constraint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateVersionConstraint,
+				ConflictsWith: []string{"version"},
+			},
+
+			"accept_marketplace_agreement": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
+			},
+
+			"marketplace_agreement_accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"plan": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"publisher": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"plan": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"product": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"publisher": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -62,6 +141,17 @@ func dataSourcePlatformImageRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error reading Platform Images: %+v", err)
 	}
 
+	if result.Value == nil || len(*result.Value) == 0 {
+		return fmt.Errorf("no Platform Images were found (location %q / publisher %q / offer %q / sku %q)", location, publisher, offer, sku)
+	}
+
+	available := make([]string, 0)
+	for _, item := range *result.Value {
+		if item.Name != nil {
+			available = append(available, *item.Name)
+		}
+	}
+
 	var image *compute.VirtualMachineImageResource
 	if v, ok := d.GetOk("version"); ok {
 		version := v.(string)
@@ -74,12 +164,55 @@ func dataSourcePlatformImageRead(d *schema.ResourceData, meta interface{}) error
 		if image == nil {
 			return fmt.Errorf("could not find image (location %q / publisher %q / offer %q / sku %q / version % q): %+v", location, publisher, offer, sku, version, err)
 		}
+	} else if constraint := d.Get("version_constraint").(string); constraint != "" {
+		version, err := latestVersionMatchingConstraint(available, constraint)
+		if err != nil {
+			return fmt.Errorf("determining the latest Platform Image version (location %q / publisher %q / offer %q / sku %q): %+v", location, publisher, offer, sku, err)
+		}
+		for _, item := range *result.Value {
+			if item.Name != nil && *item.Name == version {
+				image = &item
+				break
+			}
+		}
 	} else {
 		// get the latest image
 		// the last value is the latest, apparently.
 		image = &(*result.Value)[len(*result.Value)-1]
 	}
 
+	details, err := client.Get(ctx, location, publisher, offer, sku, *image.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Platform Image (location %q / publisher %q / offer %q / sku %q / version %q): %+v", location, publisher, offer, sku, *image.Name, err)
+	}
+
+	agreementAccepted := false
+	if plan := platformImagePlan(details); plan != nil {
+		agreementClient := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+		planPublisher, planProduct, planName := *plan.Publisher, *plan.Product, *plan.Name
+
+		acceptAgreement := d.Get("accept_marketplace_agreement").(bool)
+
+		terms, err := agreementClient.Get(ctx, planPublisher, planProduct, planName)
+		if err != nil {
+			// the Marketplace Terms are only required to accept the agreement, so when that's not requested
+			// (e.g. the caller doesn't have access to the Marketplace) we treat the agreement as not accepted
+			if acceptAgreement {
+				return fmt.Errorf("retrieving the Marketplace Terms for Publisher %q / Offer %q / Plan %q: %+v", planPublisher, planProduct, planName, err)
+			}
+			log.Printf("[WARN] Unable to retrieve the Marketplace Terms for Publisher %q / Offer %q / Plan %q - assuming they haven't been accepted: %+v", planPublisher, planProduct, planName, err)
+		} else if props := terms.AgreementProperties; props != nil && props.Accepted != nil {
+			agreementAccepted = *props.Accepted
+		}
+
+		if !agreementAccepted && acceptAgreement {
+			if err := acceptMarketplaceAgreement(ctx, agreementClient, planPublisher, planProduct, planName); err != nil {
+				return err
+			}
+			agreementAccepted = true
+		}
+	}
+
 	d.SetId(*image.ID)
 	if location := image.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
@@ -89,6 +222,60 @@ func dataSourcePlatformImageRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("offer", offer)
 	d.Set("sku", sku)
 	d.Set("version", image.Name)
+	d.Set("marketplace_agreement_accepted", agreementAccepted)
+
+	if err := d.Set("plan", flattenPlatformImagePlan(platformImagePlan(details))); err != nil {
+		return fmt.Errorf("setting `plan`: %+v", err)
+	}
+
+	// the Plan is only returned when retrieving an individual version, so each version has to be retrieved
+	versions := make([]interface{}, 0)
+	for _, version := range sortVersions(available) {
+		versionDetails := details
+		if version != *image.Name {
+			versionDetails, err = client.Get(ctx, location, publisher, offer, sku, version)
+			if err != nil {
+				return fmt.Errorf("retrieving Platform Image (location %q / publisher %q / offer %q / sku %q / version %q): %+v", location, publisher, offer, sku, version, err)
+			}
+		}
+
+		versions = append(versions, map[string]interface{}{
+			"version": version,
+			"plan":    flattenPlatformImagePlan(platformImagePlan(versionDetails)),
+		})
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
 
 	return nil
 }
+
+// platformImagePlan returns the Marketplace Plan required to use this Platform Image, if any
+func platformImagePlan(input compute.VirtualMachineImage) *compute.PurchasePlan {
+	props := input.VirtualMachineImageProperties
+	if props == nil || props.Plan == nil {
+		return nil
+	}
+
+	plan := props.Plan
+	if plan.Name == nil || plan.Product == nil || plan.Publisher == nil {
+		return nil
+	}
+
+	return plan
+}
+
+func flattenPlatformImagePlan(input *compute.PurchasePlan) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":      *input.Name,
+			"product":   *input.Product,
+			"publisher": *input.Publisher,
+		},
+	}
+}
@@ -47,6 +47,44 @@ func TestAccDataSourcePlatformImage_withVersion(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePlatformImage_versionConstraint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_platform_image", "test")
+	r := PlatformImageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.versionConstraint(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("versions.#").Exists(),
+				check.That(data.ResourceName).Key("versions.0.version").Exists(),
+				check.That(data.ResourceName).Key("versions.0.plan.#").HasValue("0"),
+				check.That(data.ResourceName).Key("plan.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePlatformImage_marketplacePlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_platform_image", "test")
+	r := PlatformImageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.marketplacePlan(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("plan.#").HasValue("1"),
+				check.That(data.ResourceName).Key("plan.0.name").HasValue("jenkins-operations-center-solo"),
+				check.That(data.ResourceName).Key("plan.0.product").HasValue("jenkins-operations-center"),
+				check.That(data.ResourceName).Key("plan.0.publisher").HasValue("cloudbees"),
+				check.That(data.ResourceName).Key("versions.0.plan.#").HasValue("1"),
+				check.That(data.ResourceName).Key("versions.0.plan.0.name").HasValue("jenkins-operations-center-solo"),
+				check.That(data.ResourceName).Key("marketplace_agreement_accepted").HasValue("true"),
+			),
+		},
+	})
+}
+
 func (PlatformImageDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -77,3 +115,35 @@ data "azurerm_platform_image" "test" {
 }
 `, data.Locations.Primary)
 }
+
+func (PlatformImageDataSource) versionConstraint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_platform_image" "test" {
+  location           = "%s"
+  publisher          = "Canonical"
+  offer              = "UbuntuServer"
+  sku                = "16.04-LTS"
+  version_constraint = "~> 16.04.201811010"
+}
+`, data.Locations.Primary)
+}
+
+func (PlatformImageDataSource) marketplacePlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_platform_image" "test" {
+  location                     = "%s"
+  publisher                    = "cloudbees"
+  offer                        = "jenkins-operations-center"
+  sku                          = "jenkins-operations-center-solo"
+  accept_marketplace_agreement = true
+}
+`, data.Locations.Primary)
+}
@@ -40,6 +40,10 @@ func dataSourceSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"incremental_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"creation_option": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -133,6 +137,12 @@ func dataSourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("disk_size_gb", int(*props.DiskSizeGB))
 		}
 
+		incrementalEnabled := false
+		if props.Incremental != nil {
+			incrementalEnabled = *props.Incremental
+		}
+		d.Set("incremental_enabled", incrementalEnabled)
+
 		if err := d.Set("encryption_settings", flattenManagedDiskEncryptionSettings(props.EncryptionSettingsCollection)); err != nil {
 			return fmt.Errorf("Error setting `encryption_settings`: %+v", err)
 		}
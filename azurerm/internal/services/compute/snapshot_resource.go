@@ -83,6 +83,13 @@ func resourceSnapshot() *schema.Resource {
 
 			"encryption_settings": encryptionSettingsSchema(),
 
+			"incremental_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -118,6 +125,7 @@ func resourceSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 			CreationData: &compute.CreationData{
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
+			Incremental: utils.Bool(d.Get("incremental_enabled").(bool)),
 		},
 		Tags: tags.Expand(t),
 	}
@@ -207,6 +215,12 @@ func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("disk_size_gb", int(*props.DiskSizeGB))
 		}
 
+		incrementalEnabled := false
+		if props.Incremental != nil {
+			incrementalEnabled = *props.Incremental
+		}
+		d.Set("incremental_enabled", incrementalEnabled)
+
 		if err := d.Set("encryption_settings", flattenManagedDiskEncryptionSettings(props.EncryptionSettingsCollection)); err != nil {
 			return fmt.Errorf("Error setting `encryption_settings`: %+v", err)
 		}
@@ -50,6 +50,32 @@ func SharedImageVersionName(v interface{}, k string) (warnings []string, errors
 	return warnings, errors
 }
 
+func GalleryApplicationName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s can only contain alphanumeric, full stops, dashes and underscores and must start and end with an alphanumeric character. Got %q.", k, value))
+	}
+
+	length := len(value)
+	if length >= 80 {
+		errors = append(errors, fmt.Errorf("%s can be up to 80 characters, currently %d.", k, length))
+	}
+
+	return warnings, errors
+}
+
+func GalleryApplicationVersionName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// unlike Shared Image Versions, `latest` and `recent` aren't valid names for an Application Version
+	if !regexp.MustCompile(`^([0-9]{1,10}\.[0-9]{1,10}\.[0-9]{1,10})$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("Expected %s to be in the format `1.2.3` but got %q.", k, value))
+	}
+
+	return warnings, errors
+}
+
 // VirtualMachineTimeZone returns a case-sensitive validation function for the Time Zones for a Virtual Machine
 func VirtualMachineTimeZone() schema.SchemaValidateFunc {
 	return virtualMachineTimeZone(false)
@@ -175,6 +175,108 @@ func TestSharedImageVersionName(t *testing.T) {
 	}
 }
 
+func TestGalleryApplicationName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-world_1.2",
+			ShouldError: false,
+		},
+		{
+			Input:       "-hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello world",
+			ShouldError: true,
+		},
+		{
+			Input:       "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestGalleryApplicationVersionName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.2.3",
+			ShouldError: false,
+		},
+		{
+			Input:       "0.0.1",
+			ShouldError: false,
+		},
+		{
+			Input:       "latest",
+			ShouldError: true,
+		},
+		{
+			Input:       "recent",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.2.3.4",
+			ShouldError: true,
+		},
+		{
+			Input:       "a.b.c",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationVersionName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
 func TestVirtualMachineTimeZone(t *testing.T) {
 	cases := []struct {
 		Value  string
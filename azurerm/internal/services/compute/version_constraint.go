@@ -0,0 +1,78 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+)
+
+func validateVersionConstraint(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := version.NewConstraint(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint (e.g. `~> 2.1`): %+v", k, err))
+	}
+
+	return
+}
+
+// sortVersions sorts the versions in ascending semantic order, placing any versions
+// which can't be parsed at the start of the list
+func sortVersions(input []string) []string {
+	output := make([]string, len(input))
+	copy(output, input)
+
+	sort.SliceStable(output, func(i, j int) bool {
+		vi, erri := version.NewVersion(output[i])
+		vj, errj := version.NewVersion(output[j])
+		if erri != nil || errj != nil {
+			return erri != nil && errj == nil
+		}
+
+		return vi.LessThan(vj)
+	})
+
+	return output
+}
+
+// latestVersionMatchingConstraint returns the highest version matching the constraint (if specified)
+func latestVersionMatchingConstraint(input []string, constraint string) (string, error) {
+	var constraints version.Constraints
+	if constraint != "" {
+		c, err := version.NewConstraint(constraint)
+		if err != nil {
+			return "", fmt.Errorf("parsing version constraint %q: %+v", constraint, err)
+		}
+		constraints = c
+	}
+
+	var latest *version.Version
+	for _, raw := range input {
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+
+		if constraints != nil && !constraints.Check(v) {
+			continue
+		}
+
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+
+	if latest == nil {
+		if constraint != "" {
+			return "", fmt.Errorf("no versions matched the constraint %q", constraint)
+		}
+		return "", fmt.Errorf("no versions were found")
+	}
+
+	return latest.Original(), nil
+}
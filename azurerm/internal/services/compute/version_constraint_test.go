@@ -0,0 +1,112 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortVersions(t *testing.T) {
+	input := []string{"2.1.10", "1.10.5", "2.1.2", "invalid", "1.9.0", "2.0"}
+	expected := []string{"invalid", "1.9.0", "1.10.5", "2.0", "2.1.2", "2.1.10"}
+
+	actual := sortVersions(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if input[0] != "2.1.10" {
+		t.Fatalf("Expected the input to be left unchanged but it was sorted")
+	}
+}
+
+func TestLatestVersionMatchingConstraint(t *testing.T) {
+	versions := []string{"1.9.0", "1.10.5", "2.0.1", "2.1.2", "2.1.10", "invalid"}
+
+	cases := []struct {
+		Name       string
+		Versions   []string
+		Constraint string
+		Expected   string
+		ShouldErr  bool
+	}{
+		{
+			Name:     "no constraint",
+			Versions: versions,
+			Expected: "2.1.10",
+		},
+		{
+			Name:       "pessimistic constraint on major",
+			Versions:   versions,
+			Constraint: "~> 1.9",
+			Expected:   "1.10.5",
+		},
+		{
+			Name:       "pessimistic constraint on minor",
+			Versions:   versions,
+			Constraint: "~> 2.0.0",
+			Expected:   "2.0.1",
+		},
+		{
+			Name:       "range",
+			Versions:   versions,
+			Constraint: ">= 1.10, < 2.1",
+			Expected:   "2.0.1",
+		},
+		{
+			Name:       "nothing matches",
+			Versions:   versions,
+			Constraint: ">= 3.0",
+			ShouldErr:  true,
+		},
+		{
+			Name:      "no versions",
+			Versions:  []string{},
+			ShouldErr: true,
+		},
+		{
+			Name:       "invalid constraint",
+			Versions:   versions,
+			Constraint: "latest",
+			ShouldErr:  true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := latestVersionMatchingConstraint(v.Versions, v.Constraint)
+		if err != nil {
+			if v.ShouldErr {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ShouldErr {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineExtensionTypeHandlerVersion(t *testing.T) {
+	cases := map[string]string{
+		"2.1.10":  "2.1",
+		"1.10":    "1.10",
+		"1":       "1.0",
+		"1.0.0.3": "1.0",
+		"invalid": "invalid",
+	}
+
+	for input, expected := range cases {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		if actual := virtualMachineExtensionTypeHandlerVersion(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVirtualMachineExtensionImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVirtualMachineExtensionImageRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": location.Schema(),
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVersionConstraint,
+			},
+
+			"compute_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"supports_multiple_extensions": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"type_handler_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"vm_scale_set_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineExtensionImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMExtensionImageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+	publisher := d.Get("publisher").(string)
+	extensionType := d.Get("type").(string)
+
+	resp, err := client.ListVersions(ctx, loc, publisher, extensionType, "", nil, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Virtual Machine Extension Image (Location %q / Publisher %q / Type %q) was not found", loc, publisher, extensionType)
+		}
+		return fmt.Errorf("listing versions for Virtual Machine Extension Image (Location %q / Publisher %q / Type %q): %+v", loc, publisher, extensionType, err)
+	}
+
+	available := make([]string, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name != nil {
+				available = append(available, *v.Name)
+			}
+		}
+	}
+
+	versions := sortVersions(available)
+	latest, err := latestVersionMatchingConstraint(versions, d.Get("version_constraint").(string))
+	if err != nil {
+		return fmt.Errorf("determining latest version for Virtual Machine Extension Image (Location %q / Publisher %q / Type %q): %+v", loc, publisher, extensionType, err)
+	}
+
+	image, err := client.Get(ctx, loc, publisher, extensionType, latest)
+	if err != nil {
+		return fmt.Errorf("retrieving Virtual Machine Extension Image (Location %q / Publisher %q / Type %q / Version %q): %+v", loc, publisher, extensionType, latest, err)
+	}
+	if image.ID == nil || *image.ID == "" {
+		return fmt.Errorf("retrieving Virtual Machine Extension Image (Location %q / Publisher %q / Type %q / Version %q): `id` was nil", loc, publisher, extensionType, latest)
+	}
+
+	d.SetId(*image.ID)
+	d.Set("location", loc)
+	d.Set("publisher", publisher)
+	d.Set("type", extensionType)
+	d.Set("version", latest)
+	d.Set("type_handler_version", virtualMachineExtensionTypeHandlerVersion(latest))
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+
+	computeRole := ""
+	operatingSystem := ""
+	supportsMultipleExtensions := false
+	vmScaleSetEnabled := false
+	if props := image.VirtualMachineExtensionImageProperties; props != nil {
+		if props.ComputeRole != nil {
+			computeRole = *props.ComputeRole
+		}
+		if props.OperatingSystem != nil {
+			operatingSystem = *props.OperatingSystem
+		}
+		if props.SupportsMultipleExtensions != nil {
+			supportsMultipleExtensions = *props.SupportsMultipleExtensions
+		}
+		if props.VMScaleSetEnabled != nil {
+			vmScaleSetEnabled = *props.VMScaleSetEnabled
+		}
+	}
+	d.Set("compute_role", computeRole)
+	d.Set("operating_system", operatingSystem)
+	d.Set("supports_multiple_extensions", supportsMultipleExtensions)
+	d.Set("vm_scale_set_enabled", vmScaleSetEnabled)
+
+	return nil
+}
+
+// virtualMachineExtensionTypeHandlerVersion returns the `major.minor` form of the version, which is
+// what the `type_handler_version` field of the Virtual Machine Extension resources expects
+func virtualMachineExtensionTypeHandlerVersion(input string) string {
+	v, err := version.NewVersion(input)
+	if err != nil {
+		return input
+	}
+
+	segments := v.Segments()
+	return fmt.Sprintf("%d.%d", segments[0], segments[1])
+}
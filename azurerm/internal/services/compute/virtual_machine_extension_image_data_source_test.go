@@ -0,0 +1,73 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineExtensionImageDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineExtensionImage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_extension_image", "test")
+	r := VirtualMachineExtensionImageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("type_handler_version").Exists(),
+				check.That(data.ResourceName).Key("versions.#").Exists(),
+				check.That(data.ResourceName).Key("operating_system").HasValue("Linux"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineExtensionImage_versionConstraint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_extension_image", "test")
+	r := VirtualMachineExtensionImageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.versionConstraint(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("type_handler_version").HasValue("2.0"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineExtensionImageDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_extension_image" "test" {
+  location  = "%s"
+  publisher = "Microsoft.Azure.Extensions"
+  type      = "CustomScript"
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineExtensionImageDataSource) versionConstraint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_extension_image" "test" {
+  location           = "%s"
+  publisher          = "Microsoft.Azure.Extensions"
+  type               = "CustomScript"
+  version_constraint = "~> 2.0.0"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,79 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVirtualMachineExtensionTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVirtualMachineExtensionTypesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": location.Schema(),
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineExtensionTypesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMExtensionImageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+	publisher := d.Get("publisher").(string)
+
+	resp, err := client.ListTypes(ctx, loc, publisher)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Virtual Machine Extension Publisher %q was not found in location %q", publisher, loc)
+		}
+		return fmt.Errorf("listing Virtual Machine Extension Types for Publisher %q (Location %q): %+v", publisher, loc, err)
+	}
+
+	names := make([]string, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name != nil {
+				names = append(names, *v.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("location", loc)
+	d.Set("publisher", publisher)
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("setting `names`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineExtensionTypesDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineExtensionTypes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_extension_types", "test")
+	r := VirtualMachineExtensionTypesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").Exists(),
+				check.That(data.ResourceName).Key("names.0").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineExtensionTypesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_extension_types" "test" {
+  location  = "%s"
+  publisher = "Microsoft.Azure.Extensions"
+}
+`, data.Locations.Primary)
+}
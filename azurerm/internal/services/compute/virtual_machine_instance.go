@@ -32,3 +32,25 @@ func virtualMachineShouldBeStarted(instanceView compute.VirtualMachineInstanceVi
 
 	return false
 }
+
+// virtualMachinePowerState returns the Power State (e.g. `running` or `deallocated`) from
+// the Instance View Statuses of a Virtual Machine or Virtual Machine Scale Set Instance
+func virtualMachinePowerState(statuses *[]compute.InstanceViewStatus) string {
+	if statuses != nil {
+		for _, status := range *statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			// could also be the provisioning state which we're not bothered with here
+			state := strings.ToLower(*status.Code)
+			if !strings.HasPrefix(state, "powerstate/") {
+				continue
+			}
+
+			return strings.TrimPrefix(state, "powerstate/")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,367 @@
+package compute
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// virtualMachineRunCommandSchema returns the schema shared between the Run Command resources
+// for Virtual Machines and Virtual Machine Scale Set instances
+func virtualMachineRunCommandSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// NOTE: changing any of these fields (other than `tags`) re-runs the Run Command
+		"source": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"command_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script_uri": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+				},
+			},
+		},
+
+		"error_blob_uri": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"output_blob_uri": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"parameter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      schema.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"run_as_password": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"run_as_user": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timeout_in_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 5400),
+		},
+
+		"tags": tags.Schema(),
+
+		"instance_view": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"end_time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					"execution_state": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandProperties(d *schema.ResourceData) *compute.VirtualMachineRunCommandProperties {
+	props := compute.VirtualMachineRunCommandProperties{
+		// the Run Command needs to have completed to expose the output/exit code
+		AsyncExecution:      utils.Bool(false),
+		Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+		Source:              expandVirtualMachineRunCommandSource(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("error_blob_uri"); ok {
+		props.ErrorBlobURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_blob_uri"); ok {
+		props.OutputBlobURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("run_as_user"); ok {
+		props.RunAsUser = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("run_as_password"); ok {
+		props.RunAsPassword = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timeout_in_seconds"); ok {
+		props.TimeoutInSeconds = utils.Int32(int32(v.(int)))
+	}
+
+	return &props
+}
+
+// setVirtualMachineRunCommandProperties sets the fields returned from the API - `protected_parameter`
+// and `run_as_password` aren't returned and are therefore kept as-is from the config
+func setVirtualMachineRunCommandProperties(d *schema.ResourceData, props *compute.VirtualMachineRunCommandProperties) error {
+	if props == nil {
+		return nil
+	}
+
+	d.Set("error_blob_uri", props.ErrorBlobURI)
+	d.Set("output_blob_uri", props.OutputBlobURI)
+	d.Set("run_as_user", props.RunAsUser)
+
+	timeoutInSeconds := 0
+	if props.TimeoutInSeconds != nil {
+		timeoutInSeconds = int(*props.TimeoutInSeconds)
+	}
+	d.Set("timeout_in_seconds", timeoutInSeconds)
+
+	if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source)); err != nil {
+		return err
+	}
+
+	if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+		return err
+	}
+
+	return d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView))
+}
+
+func expandVirtualMachineRunCommandSource(input []interface{}) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := compute.VirtualMachineRunCommandScriptSource{}
+
+	if v := raw["command_id"].(string); v != "" {
+		output.CommandID = utils.String(v)
+	}
+
+	if v := raw["script"].(string); v != "" {
+		output.Script = utils.String(v)
+	}
+
+	if v := raw["script_uri"].(string); v != "" {
+		output.ScriptURI = utils.String(v)
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	commandId := ""
+	if input.CommandID != nil {
+		commandId = *input.CommandID
+	}
+
+	script := ""
+	if input.Script != nil {
+		script = *input.Script
+	}
+
+	scriptUri := ""
+	if input.ScriptURI != nil {
+		scriptUri = *input.ScriptURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"command_id": commandId,
+			"script":     script,
+			"script_uri": scriptUri,
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []interface{}) *[]compute.RunCommandInputParameter {
+	output := make([]compute.RunCommandInputParameter, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		output = append(output, compute.RunCommandInputParameter{
+			Name:  utils.String(raw["name"].(string)),
+			Value: utils.String(raw["value"].(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		value := ""
+		if v.Value != nil {
+			value = *v.Value
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	errorMessage := ""
+	if input.Error != nil {
+		errorMessage = *input.Error
+	}
+
+	executionMessage := ""
+	if input.ExecutionMessage != nil {
+		executionMessage = *input.ExecutionMessage
+	}
+
+	exitCode := 0
+	if input.ExitCode != nil {
+		exitCode = int(*input.ExitCode)
+	}
+
+	output := ""
+	if input.Output != nil {
+		output = *input.Output
+	}
+
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"end_time":          endTime,
+			"error_message":     errorMessage,
+			"execution_message": executionMessage,
+			"execution_state":   string(input.ExecutionState),
+			"exit_code":         exitCode,
+			"output":            output,
+			"start_time":        startTime,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualMachineRunCommand() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualMachineRunCommandName,
+		},
+
+		"virtual_machine_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		"location": location.SchemaComputed(),
+	}
+	for k, v := range virtualMachineRunCommandSchema() {
+		resourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Create: resourceVirtualMachineRunCommandCreateUpdate,
+		Read:   resourceVirtualMachineRunCommandRead,
+		Update: resourceVirtualMachineRunCommandCreateUpdate,
+		Delete: resourceVirtualMachineRunCommandDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualMachineRunCommandID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceVirtualMachineRunCommandCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_run_command", id.ID())
+		}
+	}
+
+	virtualMachine, err := vmClient.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+	}
+
+	runCommand := compute.VirtualMachineRunCommand{
+		Location:                           virtualMachine.Location,
+		VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(d),
+		Tags:                               tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// NOTE: the Run Command is (re-)executed each time it's created/updated
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_id", parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if err := setVirtualMachineRunCommandProperties(d, resp.VirtualMachineRunCommandProperties); err != nil {
+		return fmt.Errorf("setting properties for %s: %+v", *id, err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualMachineRunCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func virtualMachineRunCommandName(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+		"The name must be between 1 and 80 characters, start with an alphanumeric character, end with an alphanumeric character or underscore and can contain only alphanumeric characters, underscores, full stops and hyphens.",
+	)(i, k)
+}
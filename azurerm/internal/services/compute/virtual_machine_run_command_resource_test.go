@@ -0,0 +1,166 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct {
+}
+
+func TestAccVirtualMachineRunCommand_linux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.linux(data, "echo 'hello world'"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("instance_view.0.output").Exists(),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_linuxUpdateScript(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.linux(data, "echo 'hello world'"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+		{
+			// changing the script re-runs the Run Command
+			Config: r.linux(data, "exit 3"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("3"),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.linux(data, "echo 'hello world'"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_windows(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.windows(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+	})
+}
+
+func (VirtualMachineRunCommandResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMRunCommandsClient.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (VirtualMachineRunCommandResource) linux(data acceptance.TestData, script string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  source {
+    script = "%s"
+  }
+
+  parameter {
+    name  = "greeting"
+    value = "hello"
+  }
+
+  protected_parameter {
+    name  = "secret"
+    value = "s3cr3t"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, LinuxVirtualMachineResource{}.authPassword(data), data.RandomInteger, script)
+}
+
+func (r VirtualMachineRunCommandResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "import" {
+  name               = azurerm_virtual_machine_run_command.test.name
+  virtual_machine_id = azurerm_virtual_machine_run_command.test.virtual_machine_id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.linux(data, "echo 'hello world'"))
+}
+
+func (VirtualMachineRunCommandResource) windows(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  virtual_machine_id = azurerm_windows_virtual_machine.test.id
+  timeout_in_seconds = 600
+
+  source {
+    script = "Write-Output 'hello world'"
+  }
+}
+`, WindowsVirtualMachineResource{}.authPassword(data), data.RandomInteger)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/rickb777/date/period"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
@@ -1223,6 +1224,87 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpg
 	}
 }
 
+func VirtualMachineScaleSetRollingUpgradeOrchestrationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+
+				"cancel_on_timeout": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
+// ValidateVirtualMachineScaleSetRollingUpgradeOrchestration ensures the batch settings are only configured when the
+// instances are upgraded in batches by the provider - since when `upgrade_mode` is `Automatic` or `Rolling` the batches
+// are managed by Azure according to the `rolling_upgrade_policy` block instead
+func ValidateVirtualMachineScaleSetRollingUpgradeOrchestration(upgradeMode compute.UpgradeMode, input []interface{}) error {
+	if upgradeMode == compute.UpgradeModeManual || len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// these fields have a default value, so it's only possible to tell that they've been configured when they differ from it
+	defaults := map[string]interface{}{
+		"batch_instance_percent":                  20,
+		"max_unhealthy_upgraded_instance_percent": 20,
+		"pause_time_between_batches":              "PT0S",
+	}
+	for _, key := range []string{"batch_instance_percent", "max_unhealthy_upgraded_instance_percent", "pause_time_between_batches"} {
+		if raw[key] != defaults[key] {
+			return fmt.Errorf("`%s` within the `rolling_upgrade_orchestration` block can only be configured when `upgrade_mode` is set to %q", key, string(compute.UpgradeModeManual))
+		}
+	}
+
+	return nil
+}
+
+func ExpandVirtualMachineScaleSetRollingUpgradeOrchestration(input []interface{}) *virtualMachineScaleSetRollingUpgradeOrchestration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// this has been validated as an ISO8601 Duration so it's safe to parse
+	pauseTimeBetweenBatches := period.MustParse(raw["pause_time_between_batches"].(string)).DurationApprox()
+
+	return &virtualMachineScaleSetRollingUpgradeOrchestration{
+		BatchInstancePercent:                raw["batch_instance_percent"].(int),
+		CancelOnTimeout:                     raw["cancel_on_timeout"].(bool),
+		MaxUnhealthyUpgradedInstancePercent: raw["max_unhealthy_upgraded_instance_percent"].(int),
+		PauseTimeBetweenBatches:             pauseTimeBetweenBatches,
+	}
+}
+
 func VirtualMachineScaleSetTerminateNotificationSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -1,9 +1,12 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -31,6 +34,12 @@ func dataSourceVirtualMachineScaleSet() *schema.Resource {
 
 			"location": azure.SchemaLocationForDataSource(),
 
+			"include_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"identity": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -56,12 +65,80 @@ func dataSourceVirtualMachineScaleSet() *schema.Resource {
 					},
 				},
 			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"computer_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"latest_model_applied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"protect_from_scale_in": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"protect_from_scale_set_actions": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"virtual_machine_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	instancesClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	networkInterfacesClient := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -90,5 +167,108 @@ func dataSourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	// retrieving the instances requires listing the instances and then the Network Interfaces for each instance,
+	// which can be expensive for large Scale Sets - so this is opt-in
+	instances := make([]interface{}, 0)
+	if d.Get("include_instances").(bool) {
+		instances, err = flattenVirtualMachineScaleSetInstances(ctx, instancesClient, networkInterfacesClient, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("setting `instances`: %+v", err)
+	}
+
 	return nil
 }
+
+func flattenVirtualMachineScaleSetInstances(ctx context.Context, instancesClient *compute.VirtualMachineScaleSetVMsClient, nicClient *network.InterfacesClient, resourceGroup, scaleSetName string) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	iterator, err := instancesClient.ListComplete(ctx, resourceGroup, scaleSetName, "", "", "instanceView")
+	if err != nil {
+		return nil, err
+	}
+
+	for iterator.NotDone() {
+		instance := iterator.Value()
+
+		instanceId := ""
+		if instance.InstanceID != nil {
+			instanceId = *instance.InstanceID
+		}
+
+		name := ""
+		if instance.Name != nil {
+			name = *instance.Name
+		}
+
+		virtualMachineId := ""
+		if instance.ID != nil {
+			virtualMachineId = *instance.ID
+		}
+
+		zone := ""
+		if instance.Zones != nil && len(*instance.Zones) > 0 {
+			zone = (*instance.Zones)[0]
+		}
+
+		computerName := ""
+		latestModelApplied := false
+		powerState := ""
+		protectFromScaleIn := false
+		protectFromScaleSetActions := false
+		if props := instance.VirtualMachineScaleSetVMProperties; props != nil {
+			if props.OsProfile != nil && props.OsProfile.ComputerName != nil {
+				computerName = *props.OsProfile.ComputerName
+			}
+
+			if props.LatestModelApplied != nil {
+				latestModelApplied = *props.LatestModelApplied
+			}
+
+			if props.InstanceView != nil {
+				powerState = virtualMachinePowerState(props.InstanceView.Statuses)
+			}
+
+			if policy := props.ProtectionPolicy; policy != nil {
+				if policy.ProtectFromScaleIn != nil {
+					protectFromScaleIn = *policy.ProtectFromScaleIn
+				}
+				if policy.ProtectFromScaleSetActions != nil {
+					protectFromScaleSetActions = *policy.ProtectFromScaleSetActions
+				}
+			}
+		}
+
+		privateIPAddresses, err := retrievePrivateIPAddressesForScaleSetInstance(ctx, nicClient, resourceGroup, scaleSetName, instanceId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Network Interfaces for Instance %q: %+v", instanceId, err)
+		}
+		privateIPAddress := ""
+		if len(privateIPAddresses) > 0 {
+			privateIPAddress = privateIPAddresses[0]
+		}
+
+		output = append(output, map[string]interface{}{
+			"computer_name":                  computerName,
+			"instance_id":                    instanceId,
+			"latest_model_applied":           latestModelApplied,
+			"name":                           name,
+			"power_state":                    powerState,
+			"private_ip_address":             privateIPAddress,
+			"private_ip_addresses":           privateIPAddresses,
+			"protect_from_scale_in":          protectFromScaleIn,
+			"protect_from_scale_set_actions": protectFromScaleSetActions,
+			"virtual_machine_id":             virtualMachineId,
+			"zone":                           zone,
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
@@ -23,6 +23,26 @@ func TestAccDataSourceVirtualMachineScaleSet_basicLinux(t *testing.T) {
 				check.That(data.ResourceName).Key("identity.#").HasValue("1"),
 				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
 				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+				check.That(data.ResourceName).Key("instances.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineScaleSet_instances(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_scale_set", "test")
+	r := VirtualMachineScaleSetDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.instances(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("instances.#").HasValue("1"),
+				check.That(data.ResourceName).Key("instances.0.instance_id").Exists(),
+				check.That(data.ResourceName).Key("instances.0.latest_model_applied").HasValue("true"),
+				check.That(data.ResourceName).Key("instances.0.power_state").HasValue("running"),
+				check.That(data.ResourceName).Key("instances.0.private_ip_address").Exists(),
+				check.That(data.ResourceName).Key("instances.0.virtual_machine_id").Exists(),
 			),
 		},
 	})
@@ -70,6 +90,18 @@ data "azurerm_virtual_machine_scale_set" "test" {
 `, template)
 }
 
+func (VirtualMachineScaleSetDataSource) instances(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set" "test" {
+  name                = azurerm_linux_virtual_machine_scale_set.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  include_instances   = true
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data))
+}
+
 func (VirtualMachineScaleSetDataSource) basicWindows(data acceptance.TestData) string {
 	template := WindowsVirtualMachineScaleSetResource{}.identitySystemAssigned(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,236 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualMachineScaleSetResourceName = "azurerm_virtual_machine_scale_set"
+
+func resourceVirtualMachineScaleSetInstanceProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualMachineScaleSetInstanceProtectionCreate,
+		Read:   resourceVirtualMachineScaleSetInstanceProtectionRead,
+		Update: resourceVirtualMachineScaleSetInstanceProtectionUpdate,
+		Delete: resourceVirtualMachineScaleSetInstanceProtectionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualMachineScaleSetVMID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protect_from_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protect_from_scale_set_actions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineScaleSetVMID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroup, scaleSetId.Name, d.Get("instance_id").(string))
+
+	locks.ByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the Instance always exists, so protection which has already been enabled is treated as existing
+	if policy := virtualMachineScaleSetInstanceProtectionPolicy(existing); policy != nil {
+		if (policy.ProtectFromScaleIn != nil && *policy.ProtectFromScaleIn) || (policy.ProtectFromScaleSetActions != nil && *policy.ProtectFromScaleSetActions) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_instance_protection", id.ID())
+		}
+	}
+
+	policy := &compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+	if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, id, existing, policy); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineScaleSetInstanceProtectionRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetVMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	protectFromScaleIn := false
+	protectFromScaleSetActions := false
+	if policy := virtualMachineScaleSetInstanceProtectionPolicy(resp); policy != nil {
+		if policy.ProtectFromScaleIn != nil {
+			protectFromScaleIn = *policy.ProtectFromScaleIn
+		}
+		if policy.ProtectFromScaleSetActions != nil {
+			protectFromScaleSetActions = *policy.ProtectFromScaleSetActions
+		}
+	}
+
+	d.Set("virtual_machine_scale_set_id", parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID())
+	d.Set("instance_id", id.VirtualMachineName)
+	d.Set("protect_from_scale_in", protectFromScaleIn)
+	d.Set("protect_from_scale_set_actions", protectFromScaleSetActions)
+
+	return nil
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetVMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	policy := &compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+	if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, *id, existing, policy); err != nil {
+		return err
+	}
+
+	return resourceVirtualMachineScaleSetInstanceProtectionRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetVMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	policy := &compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(false),
+		ProtectFromScaleSetActions: utils.Bool(false),
+	}
+	return updateVirtualMachineScaleSetInstanceProtection(ctx, client, *id, existing, policy)
+}
+
+func virtualMachineScaleSetInstanceProtectionPolicy(input compute.VirtualMachineScaleSetVM) *compute.VirtualMachineScaleSetVMProtectionPolicy {
+	if input.VirtualMachineScaleSetVMProperties == nil {
+		return nil
+	}
+
+	return input.VirtualMachineScaleSetVMProperties.ProtectionPolicy
+}
+
+func updateVirtualMachineScaleSetInstanceProtection(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id parse.VirtualMachineScaleSetVMId, existing compute.VirtualMachineScaleSetVM, policy *compute.VirtualMachineScaleSetVMProtectionPolicy) error {
+	if existing.VirtualMachineScaleSetVMProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	// the Instance View isn't part of the model and can't be sent back to the API
+	existing.VirtualMachineScaleSetVMProperties.InstanceView = nil
+	existing.VirtualMachineScaleSetVMProperties.ProtectionPolicy = policy
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, existing)
+	if err != nil {
+		return fmt.Errorf("updating Protection Policy for %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Protection Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,417 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	// rollingUpgradePollInterval is how often the status of a Rolling Upgrade is checked
+	rollingUpgradePollInterval = 30 * time.Second
+
+	// rollingUpgradeStartTimeout is how long to wait for the platform to begin a Rolling Upgrade
+	// before assuming that no instances needed to be upgraded
+	rollingUpgradeStartTimeout = 5 * time.Minute
+
+	// rollingUpgradeCancelTimeout is how long to wait for a Rolling Upgrade to be cancelled once
+	// the operation has timed out
+	rollingUpgradeCancelTimeout = 15 * time.Minute
+)
+
+type virtualMachineScaleSetRollingUpgradeOrchestration struct {
+	// the percentage of the instances in the Scale Set to upgrade in each batch
+	BatchInstancePercent int
+
+	// should a Rolling Upgrade which is still running be cancelled when the operation times out?
+	CancelOnTimeout bool
+
+	// the maximum percentage of upgraded instances which can be unhealthy before the upgrade is aborted
+	MaxUnhealthyUpgradedInstancePercent int
+
+	// how long to wait after upgrading one batch before starting the next
+	PauseTimeBetweenBatches time.Duration
+}
+
+// upgradeInstancesUsingRollingUpgrade starts an OS Upgrade for a Scale Set using the Automatic
+// Upgrade Mode and then waits for the Rolling Upgrade to complete
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesUsingRollingUpgrade(ctx context.Context) error {
+	rollingUpgradesClient := metadata.Client.VMScaleSetRollingUpgradesClient
+	id := metadata.ID
+
+	previousRollingUpgrade, err := metadata.latestRollingUpgradeStartTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Starting OS Upgrade for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	if _, err := rollingUpgradesClient.StartOSUpgrade(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("starting OS Upgrade for %s: %+v", *id, err)
+	}
+
+	return metadata.waitForRollingUpgrade(ctx, previousRollingUpgrade)
+}
+
+// waitForRollingUpgrade waits for the Rolling Upgrade which started after `previous` to finish,
+// logging the progress of each batch and returning the failed instances if the upgrade faults
+func (metadata virtualMachineScaleSetUpdateMetaData) waitForRollingUpgrade(ctx context.Context, previous *time.Time) error {
+	rollingUpgradesClient := metadata.Client.VMScaleSetRollingUpgradesClient
+	id := metadata.ID
+
+	waitingForStartUntil := time.Now().Add(rollingUpgradeStartTimeout)
+	for {
+		resp, err := rollingUpgradesClient.GetLatest(ctx, id.ResourceGroup, id.Name)
+		if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+			if ctx.Err() != nil {
+				return metadata.rollingUpgradeTimedOut()
+			}
+			return fmt.Errorf("retrieving the latest Rolling Upgrade for %s: %+v", *id, err)
+		}
+
+		props := resp.RollingUpgradeStatusInfoProperties
+		if err == nil && props != nil && props.RunningStatus != nil && rollingUpgradeStartedAfter(props.RunningStatus, previous) {
+			if progress := props.Progress; progress != nil {
+				log.Printf("[DEBUG] Rolling Upgrade for %s is %q: %d succeeded / %d failed / %d in progress / %d pending", *id, string(props.RunningStatus.Code),
+					int32Value(progress.SuccessfulInstanceCount), int32Value(progress.FailedInstanceCount), int32Value(progress.InProgressInstanceCount), int32Value(progress.PendingInstanceCount))
+			}
+
+			switch props.RunningStatus.Code {
+			case compute.RollingUpgradeStatusCodeCompleted:
+				log.Printf("[DEBUG] Rolling Upgrade for %s completed.", *id)
+				return nil
+
+			case compute.RollingUpgradeStatusCodeCancelled:
+				return fmt.Errorf("the Rolling Upgrade for %s was cancelled", *id)
+
+			case compute.RollingUpgradeStatusCodeFaulted:
+				failedInstances := 0
+				if props.Progress != nil {
+					failedInstances = int(int32Value(props.Progress.FailedInstanceCount))
+				}
+				return fmt.Errorf("the Rolling Upgrade for %s faulted (%d failed instances: %s): %s", *id, failedInstances, formatInstanceIds(rollingUpgradeFailedInstanceIds(props.Error)), rollingUpgradeErrorMessage(props.Error))
+			}
+		} else if time.Now().After(waitingForStartUntil) {
+			log.Printf("[WARN] No Rolling Upgrade was started for %s within %s - assuming no instances needed upgrading", *id, rollingUpgradeStartTimeout)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return metadata.rollingUpgradeTimedOut()
+
+		case <-time.After(rollingUpgradePollInterval):
+		}
+	}
+}
+
+// rollingUpgradeTimedOut returns the error for a Rolling Upgrade which didn't complete in time,
+// cancelling the Rolling Upgrade first if that's been requested
+func (metadata virtualMachineScaleSetUpdateMetaData) rollingUpgradeTimedOut() error {
+	id := metadata.ID
+
+	if metadata.RollingUpgradeOrchestration == nil || !metadata.RollingUpgradeOrchestration.CancelOnTimeout {
+		return fmt.Errorf("timed out waiting for the Rolling Upgrade for %s to complete", *id)
+	}
+
+	if err := metadata.cancelRollingUpgrade(); err != nil {
+		return fmt.Errorf("timed out waiting for the Rolling Upgrade for %s to complete and then %+v", *id, err)
+	}
+
+	return fmt.Errorf("timed out waiting for the Rolling Upgrade for %s to complete - the Rolling Upgrade has been cancelled", *id)
+}
+
+// cancelRollingUpgrade cancels the running Rolling Upgrade, using a separate context since
+// this is called once the context for the operation has expired
+func (metadata virtualMachineScaleSetUpdateMetaData) cancelRollingUpgrade() error {
+	rollingUpgradesClient := metadata.Client.VMScaleSetRollingUpgradesClient
+	id := metadata.ID
+
+	ctx, cancel := context.WithTimeout(context.Background(), rollingUpgradeCancelTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Cancelling the Rolling Upgrade for %s..", *id)
+	future, err := rollingUpgradesClient.Cancel(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("cancelling the Rolling Upgrade for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, rollingUpgradesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the Rolling Upgrade for %s to be cancelled: %+v", *id, err)
+	}
+	log.Printf("[DEBUG] Cancelled the Rolling Upgrade for %s.", *id)
+
+	return nil
+}
+
+// latestRollingUpgradeStartTime returns the time the most recent Rolling Upgrade started, if there's been one
+func (metadata virtualMachineScaleSetUpdateMetaData) latestRollingUpgradeStartTime(ctx context.Context) (*time.Time, error) {
+	rollingUpgradesClient := metadata.Client.VMScaleSetRollingUpgradesClient
+	id := metadata.ID
+
+	resp, err := rollingUpgradesClient.GetLatest(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving the latest Rolling Upgrade for %s: %+v", *id, err)
+	}
+
+	if props := resp.RollingUpgradeStatusInfoProperties; props != nil && props.RunningStatus != nil && props.RunningStatus.StartTime != nil {
+		return &props.RunningStatus.StartTime.Time, nil
+	}
+
+	return nil, nil
+}
+
+// upgradeInstancesInBatches rolls the instances of a Scale Set using the Manual Upgrade Mode to the
+// latest model in batches, checking the health of the upgraded instances after each batch
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesInBatches(ctx context.Context) error {
+	client := metadata.Client.VMScaleSetClient
+	instancesClient := metadata.Client.VMScaleSetVMsClient
+	orchestration := metadata.RollingUpgradeOrchestration
+	id := metadata.ID
+
+	instances, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("listing instances for %s: %+v", *id, err)
+	}
+
+	totalInstances := 0
+	instanceIdsToRoll := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			totalInstances++
+
+			props := instance.VirtualMachineScaleSetVMProperties
+			if props == nil || props.LatestModelApplied == nil || !*props.LatestModelApplied {
+				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("enumerating instances for %s: %+v", *id, err)
+		}
+	}
+
+	batches := virtualMachineScaleSetUpgradeBatches(instanceIdsToRoll, totalInstances, orchestration.BatchInstancePercent)
+	upgradedInstanceIds := make([]string, 0)
+	for i, batch := range batches {
+		instanceIds := batch
+
+		log.Printf("[DEBUG] Updating batch %d/%d (instances %s) for %s to the Latest Configuration..", i+1, len(batches), formatInstanceIds(instanceIds), *id)
+		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &instanceIds,
+		})
+		if err != nil {
+			return fmt.Errorf("updating instances %s for %s to the Latest Configuration: %+v", formatInstanceIds(instanceIds), *id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of instances %s for %s to the Latest Configuration: %+v", formatInstanceIds(instanceIds), *id, err)
+		}
+
+		log.Printf("[DEBUG] Reimaging batch %d/%d (instances %s) for %s..", i+1, len(batches), formatInstanceIds(instanceIds), *id)
+		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, &compute.VirtualMachineScaleSetReimageParameters{
+			InstanceIds: &instanceIds,
+		})
+		if err != nil {
+			return fmt.Errorf("reimaging instances %s for %s: %+v", formatInstanceIds(instanceIds), *id, err)
+		}
+		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for reimage of instances %s for %s: %+v", formatInstanceIds(instanceIds), *id, err)
+		}
+
+		upgradedInstanceIds = append(upgradedInstanceIds, instanceIds...)
+		unhealthyInstanceIds, err := metadata.unhealthyInstanceIds(ctx, upgradedInstanceIds)
+		if err != nil {
+			return err
+		}
+		if exceedsUnhealthyInstanceThreshold(len(unhealthyInstanceIds), len(upgradedInstanceIds), orchestration.MaxUnhealthyUpgradedInstancePercent) {
+			return fmt.Errorf("aborting the upgrade of %s since %d of the %d upgraded instances are unhealthy, which exceeds `max_unhealthy_upgraded_instance_percent` (%d%%) - failed instances: %s", *id, len(unhealthyInstanceIds), len(upgradedInstanceIds), orchestration.MaxUnhealthyUpgradedInstancePercent, formatInstanceIds(unhealthyInstanceIds))
+		}
+		log.Printf("[DEBUG] Upgraded batch %d/%d for %s.", i+1, len(batches), *id)
+
+		if i < len(batches)-1 && orchestration.PauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before upgrading the next batch for %s..", orchestration.PauseTimeBetweenBatches, *id)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("timed out upgrading the instances for %s - %d of %d instances were upgraded", *id, len(upgradedInstanceIds), len(instanceIdsToRoll))
+			case <-time.After(orchestration.PauseTimeBetweenBatches):
+			}
+		}
+	}
+
+	return nil
+}
+
+// unhealthyInstanceIds returns the IDs of the instances within the Scale Set which are unhealthy, optionally
+// limited to the specified instances
+func (metadata virtualMachineScaleSetUpdateMetaData) unhealthyInstanceIds(ctx context.Context, instanceIds []string) ([]string, error) {
+	instancesClient := metadata.Client.VMScaleSetVMsClient
+	id := metadata.ID
+
+	filter := make(map[string]struct{})
+	for _, v := range instanceIds {
+		filter[v] = struct{}{}
+	}
+
+	instances, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "instanceView")
+	if err != nil {
+		return nil, fmt.Errorf("listing instances for %s: %+v", *id, err)
+	}
+
+	output := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			_, included := filter[*instance.InstanceID]
+			if instanceIds == nil || included {
+				var instanceView *compute.VirtualMachineScaleSetVMInstanceView
+				if props := instance.VirtualMachineScaleSetVMProperties; props != nil {
+					instanceView = props.InstanceView
+				}
+
+				if !virtualMachineScaleSetInstanceIsHealthy(instanceView) {
+					output = append(output, *instance.InstanceID)
+				}
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("enumerating instances for %s: %+v", *id, err)
+		}
+	}
+
+	sort.Strings(output)
+	return output, nil
+}
+
+// virtualMachineScaleSetUpgradeBatches splits the instances to upgrade into batches, where each batch contains
+// the specified percentage of the total instances in the Scale Set (rounded up)
+func virtualMachineScaleSetUpgradeBatches(instanceIds []string, totalInstances int, batchInstancePercent int) [][]string {
+	batchSize := (totalInstances*batchInstancePercent + 99) / 100
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for i := 0; i < len(instanceIds); i += batchSize {
+		end := i + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batches = append(batches, instanceIds[i:end])
+	}
+
+	return batches
+}
+
+// virtualMachineScaleSetInstanceIsHealthy determines whether an instance is healthy from its Instance View - where
+// an instance without health information is assumed to be healthy unless it failed to provision
+func virtualMachineScaleSetInstanceIsHealthy(input *compute.VirtualMachineScaleSetVMInstanceView) bool {
+	if input == nil {
+		return true
+	}
+
+	if health := input.VMHealth; health != nil && health.Status != nil && health.Status.Code != nil {
+		if strings.EqualFold(*health.Status.Code, "HealthState/unhealthy") {
+			return false
+		}
+	}
+
+	if input.Statuses != nil {
+		for _, status := range *input.Statuses {
+			if status.Code != nil && strings.EqualFold(*status.Code, "ProvisioningState/failed") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func exceedsUnhealthyInstanceThreshold(unhealthyInstances int, upgradedInstances int, maxUnhealthyPercent int) bool {
+	if upgradedInstances == 0 {
+		return false
+	}
+
+	return unhealthyInstances*100 > maxUnhealthyPercent*upgradedInstances
+}
+
+func rollingUpgradeStartedAfter(input *compute.RollingUpgradeRunningStatus, previous *time.Time) bool {
+	if input.StartTime == nil {
+		return false
+	}
+
+	return previous == nil || input.StartTime.Time.After(*previous)
+}
+
+func rollingUpgradeErrorMessage(input *compute.APIError) string {
+	if input == nil {
+		return "no error details were returned"
+	}
+
+	messages := make([]string, 0)
+	if input.Message != nil {
+		messages = append(messages, *input.Message)
+	}
+	if input.Details != nil {
+		for _, detail := range *input.Details {
+			if detail.Message == nil {
+				continue
+			}
+
+			if detail.Target != nil {
+				messages = append(messages, fmt.Sprintf("%s: %s", *detail.Target, *detail.Message))
+				continue
+			}
+			messages = append(messages, *detail.Message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return "no error details were returned"
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// rollingUpgradeFailedInstanceIds returns the instances which the Rolling Upgrade failed to upgrade, which are
+// returned as the targets of the error details
+func rollingUpgradeFailedInstanceIds(input *compute.APIError) []string {
+	output := make([]string, 0)
+	if input == nil || input.Details == nil {
+		return output
+	}
+
+	for _, detail := range *input.Details {
+		if detail.Target != nil && *detail.Target != "" {
+			output = append(output, *detail.Target)
+		}
+	}
+
+	sort.Strings(output)
+	return output
+}
+
+func formatInstanceIds(input []string) string {
+	if len(input) == 0 {
+		return "none"
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(input, ", "))
+}
+
+func int32Value(input *int32) int32 {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}
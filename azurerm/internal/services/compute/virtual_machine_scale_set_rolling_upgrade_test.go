@@ -0,0 +1,318 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestVirtualMachineScaleSetUpgradeBatches(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		InstanceIds          []string
+		TotalInstances       int
+		BatchInstancePercent int
+		Expected             [][]string
+	}{
+		{
+			Name:                 "No Instances",
+			InstanceIds:          []string{},
+			TotalInstances:       4,
+			BatchInstancePercent: 20,
+			Expected:             [][]string{},
+		},
+		{
+			Name:                 "Rounds Up To A Single Instance",
+			InstanceIds:          []string{"0", "1", "2"},
+			TotalInstances:       3,
+			BatchInstancePercent: 20,
+			Expected:             [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			Name:                 "Partial Final Batch",
+			InstanceIds:          []string{"0", "1", "2", "3", "4"},
+			TotalInstances:       5,
+			BatchInstancePercent: 50,
+			Expected:             [][]string{{"0", "1", "2"}, {"3", "4"}},
+		},
+		{
+			Name:                 "Batch Size Based On Total Instances",
+			InstanceIds:          []string{"3", "7"},
+			TotalInstances:       10,
+			BatchInstancePercent: 20,
+			Expected:             [][]string{{"3", "7"}},
+		},
+		{
+			Name:                 "All At Once",
+			InstanceIds:          []string{"0", "1", "2"},
+			TotalInstances:       3,
+			BatchInstancePercent: 100,
+			Expected:             [][]string{{"0", "1", "2"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		actual := virtualMachineScaleSetUpgradeBatches(testCase.InstanceIds, testCase.TotalInstances, testCase.BatchInstancePercent)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceIsHealthy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *compute.VirtualMachineScaleSetVMInstanceView
+		Expected bool
+	}{
+		{
+			Name:     "No Instance View",
+			Input:    nil,
+			Expected: true,
+		},
+		{
+			Name: "No Health Information",
+			Input: &compute.VirtualMachineScaleSetVMInstanceView{
+				Statuses: &[]compute.InstanceViewStatus{
+					{Code: utils.String("ProvisioningState/succeeded")},
+					{Code: utils.String("PowerState/running")},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Healthy",
+			Input: &compute.VirtualMachineScaleSetVMInstanceView{
+				VMHealth: &compute.VirtualMachineHealthStatus{
+					Status: &compute.InstanceViewStatus{Code: utils.String("HealthState/healthy")},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Unhealthy",
+			Input: &compute.VirtualMachineScaleSetVMInstanceView{
+				VMHealth: &compute.VirtualMachineHealthStatus{
+					Status: &compute.InstanceViewStatus{Code: utils.String("HealthState/unhealthy")},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Failed To Provision",
+			Input: &compute.VirtualMachineScaleSetVMInstanceView{
+				Statuses: &[]compute.InstanceViewStatus{
+					{Code: utils.String("ProvisioningState/failed")},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		actual := virtualMachineScaleSetInstanceIsHealthy(testCase.Input)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestExceedsUnhealthyInstanceThreshold(t *testing.T) {
+	testCases := []struct {
+		Unhealthy  int
+		Upgraded   int
+		MaxPercent int
+		Expected   bool
+	}{
+		{Unhealthy: 0, Upgraded: 0, MaxPercent: 0, Expected: false},
+		{Unhealthy: 0, Upgraded: 5, MaxPercent: 0, Expected: false},
+		{Unhealthy: 1, Upgraded: 5, MaxPercent: 0, Expected: true},
+		{Unhealthy: 1, Upgraded: 5, MaxPercent: 20, Expected: false},
+		{Unhealthy: 2, Upgraded: 5, MaxPercent: 20, Expected: true},
+		{Unhealthy: 5, Upgraded: 5, MaxPercent: 100, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		actual := exceedsUnhealthyInstanceThreshold(testCase.Unhealthy, testCase.Upgraded, testCase.MaxPercent)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t for %d/%d unhealthy (max %d%%) but got %t", testCase.Expected, testCase.Unhealthy, testCase.Upgraded, testCase.MaxPercent, actual)
+		}
+	}
+}
+
+func TestRollingUpgradeStartedAfter(t *testing.T) {
+	previous := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Input    compute.RollingUpgradeRunningStatus
+		Previous *time.Time
+		Expected bool
+	}{
+		{
+			Name:     "Not Started",
+			Input:    compute.RollingUpgradeRunningStatus{},
+			Previous: nil,
+			Expected: false,
+		},
+		{
+			Name: "First Rolling Upgrade",
+			Input: compute.RollingUpgradeRunningStatus{
+				StartTime: &date.Time{Time: previous},
+			},
+			Previous: nil,
+			Expected: true,
+		},
+		{
+			Name: "Same Rolling Upgrade",
+			Input: compute.RollingUpgradeRunningStatus{
+				StartTime: &date.Time{Time: previous},
+			},
+			Previous: &previous,
+			Expected: false,
+		},
+		{
+			Name: "New Rolling Upgrade",
+			Input: compute.RollingUpgradeRunningStatus{
+				StartTime: &date.Time{Time: previous.Add(time.Minute)},
+			},
+			Previous: &previous,
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		actual := rollingUpgradeStartedAfter(&testCase.Input, testCase.Previous)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestRollingUpgradeErrorMessage(t *testing.T) {
+	input := &compute.APIError{
+		Message: utils.String("The rolling upgrade was aborted."),
+		Details: &[]compute.APIErrorBase{
+			{
+				Target:  utils.String("2"),
+				Message: utils.String("VM has reported a failure when processing extension 'healthcheck'."),
+			},
+			{
+				Message: utils.String("Unhealthy instances exceeded the threshold."),
+			},
+		},
+	}
+
+	expected := "The rolling upgrade was aborted.; 2: VM has reported a failure when processing extension 'healthcheck'.; Unhealthy instances exceeded the threshold."
+	if actual := rollingUpgradeErrorMessage(input); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	if actual := rollingUpgradeErrorMessage(nil); actual != "no error details were returned" {
+		t.Fatalf("Expected the default message but got %q", actual)
+	}
+}
+
+func TestRollingUpgradeFailedInstanceIds(t *testing.T) {
+	input := &compute.APIError{
+		Message: utils.String("The rolling upgrade was aborted."),
+		Details: &[]compute.APIErrorBase{
+			{
+				Target:  utils.String("5"),
+				Message: utils.String("VM has reported a failure when processing extension 'healthcheck'."),
+			},
+			{
+				Message: utils.String("Unhealthy instances exceeded the threshold."),
+			},
+			{
+				Target:  utils.String("2"),
+				Message: utils.String("VM has reported a failure when processing extension 'healthcheck'."),
+			},
+		},
+	}
+
+	expected := []string{"2", "5"}
+	if actual := rollingUpgradeFailedInstanceIds(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := rollingUpgradeFailedInstanceIds(nil); len(actual) != 0 {
+		t.Fatalf("Expected no failed instances but got %+v", actual)
+	}
+}
+
+func TestValidateVirtualMachineScaleSetRollingUpgradeOrchestration(t *testing.T) {
+	orchestration := func(batchInstancePercent, maxUnhealthyUpgradedInstancePercent int, pauseTimeBetweenBatches string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"batch_instance_percent":                  batchInstancePercent,
+				"cancel_on_timeout":                       true,
+				"max_unhealthy_upgraded_instance_percent": maxUnhealthyUpgradedInstancePercent,
+				"pause_time_between_batches":              pauseTimeBetweenBatches,
+			},
+		}
+	}
+
+	testData := []struct {
+		name        string
+		upgradeMode compute.UpgradeMode
+		input       []interface{}
+		valid       bool
+	}{
+		{
+			name:        "not configured",
+			upgradeMode: compute.UpgradeModeRolling,
+			input:       []interface{}{},
+			valid:       true,
+		},
+		{
+			name:        "manual with batch settings",
+			upgradeMode: compute.UpgradeModeManual,
+			input:       orchestration(50, 0, "PT1M"),
+			valid:       true,
+		},
+		{
+			name:        "rolling with the default batch settings",
+			upgradeMode: compute.UpgradeModeRolling,
+			input:       orchestration(20, 20, "PT0S"),
+			valid:       true,
+		},
+		{
+			name:        "rolling with a batch size",
+			upgradeMode: compute.UpgradeModeRolling,
+			input:       orchestration(50, 20, "PT0S"),
+			valid:       false,
+		},
+		{
+			name:        "automatic with a maximum unhealthy percentage",
+			upgradeMode: compute.UpgradeModeAutomatic,
+			input:       orchestration(20, 0, "PT0S"),
+			valid:       false,
+		},
+		{
+			name:        "automatic with a pause between batches",
+			upgradeMode: compute.UpgradeModeAutomatic,
+			input:       orchestration(20, 20, "PT1M"),
+			valid:       false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := ValidateVirtualMachineScaleSetRollingUpgradeOrchestration(v.upgradeMode, v.input)
+		if actual := err == nil; actual != v.valid {
+			t.Fatalf("Expected %t but got %t for %q: %+v", v.valid, actual, v.name, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
@@ -18,6 +19,9 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// can we roll instances if we need too? this is a feature toggle
 	CanRollInstancesWhenRequired bool
 
+	// how should the instances be rolled? when nil the instances are rolled one at a time
+	RollingUpgradeOrchestration *virtualMachineScaleSetRollingUpgradeOrchestration
+
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
@@ -37,26 +41,51 @@ func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.C
 		update.VirtualMachineScaleSetUpdateProperties.UpgradePolicy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade = utils.Bool(false)
 	}
 
+	userWantsToRollInstances := metadata.CanRollInstancesWhenRequired
+	upgradeMode := metadata.Existing.VirtualMachineScaleSetProperties.UpgradePolicy.Mode
+	orchestrated := metadata.RollingUpgradeOrchestration != nil
+
+	// when the Upgrade Mode is Rolling, updating the model triggers a Rolling Upgrade - so we need
+	// to know which (if any) Rolling Upgrade came before this one to be able to track it
+	var previousRollingUpgrade *time.Time
+	if metadata.UpdateInstances && userWantsToRollInstances && orchestrated && upgradeMode == compute.UpgradeModeRolling {
+		startTime, err := metadata.latestRollingUpgradeStartTime(ctx)
+		if err != nil {
+			return err
+		}
+		previousRollingUpgrade = startTime
+	}
+
 	if err := metadata.updateVmss(ctx, update); err != nil {
 		return err
 	}
 
 	// if we update the SKU, we also need to subsequently roll the instances using the `UpdateInstances` API
-	if metadata.UpdateInstances {
-		userWantsToRollInstances := metadata.CanRollInstancesWhenRequired
-		upgradeMode := metadata.Existing.VirtualMachineScaleSetProperties.UpgradePolicy.Mode
-
-		if userWantsToRollInstances {
-			if upgradeMode == compute.UpgradeModeAutomatic {
-				if err := metadata.upgradeInstancesForAutomaticUpgradePolicy(ctx); err != nil {
-					return err
-				}
+	if metadata.UpdateInstances && userWantsToRollInstances {
+		switch {
+		case upgradeMode == compute.UpgradeModeAutomatic && orchestrated:
+			if err := metadata.upgradeInstancesUsingRollingUpgrade(ctx); err != nil {
+				return err
+			}
+
+		case upgradeMode == compute.UpgradeModeAutomatic:
+			if err := metadata.upgradeInstancesForAutomaticUpgradePolicy(ctx); err != nil {
+				return err
+			}
+
+		case upgradeMode == compute.UpgradeModeManual && orchestrated:
+			if err := metadata.upgradeInstancesInBatches(ctx); err != nil {
+				return err
+			}
+
+		case upgradeMode == compute.UpgradeModeManual:
+			if err := metadata.upgradeInstancesForManualUpgradePolicy(ctx); err != nil {
+				return err
 			}
 
-			if upgradeMode == compute.UpgradeModeManual {
-				if err := metadata.upgradeInstancesForManualUpgradePolicy(ctx); err != nil {
-					return err
-				}
+		case upgradeMode == compute.UpgradeModeRolling && orchestrated:
+			if err := metadata.waitForRollingUpgrade(ctx, previousRollingUpgrade); err != nil {
+				return err
 			}
 		}
 	}
@@ -0,0 +1,125 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualMachineSizes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVirtualMachineSizesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": location.Schema(),
+
+			"sizes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"max_data_disk_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"memory_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"number_of_cores": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"os_disk_size_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"resource_disk_size_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineSizesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMSizesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.List(ctx, loc)
+	if err != nil {
+		return fmt.Errorf("listing Virtual Machine Sizes for location %q: %+v", loc, err)
+	}
+
+	sizes := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name == nil {
+				continue
+			}
+
+			size := map[string]interface{}{
+				"name":                     *v.Name,
+				"max_data_disk_count":      0,
+				"memory_in_mb":             0,
+				"number_of_cores":          0,
+				"os_disk_size_in_mb":       0,
+				"resource_disk_size_in_mb": 0,
+			}
+			if v.MaxDataDiskCount != nil {
+				size["max_data_disk_count"] = int(*v.MaxDataDiskCount)
+			}
+			if v.MemoryInMB != nil {
+				size["memory_in_mb"] = int(*v.MemoryInMB)
+			}
+			if v.NumberOfCores != nil {
+				size["number_of_cores"] = int(*v.NumberOfCores)
+			}
+			if v.OsDiskSizeInMB != nil {
+				size["os_disk_size_in_mb"] = int(*v.OsDiskSizeInMB)
+			}
+			if v.ResourceDiskSizeInMB != nil {
+				size["resource_disk_size_in_mb"] = int(*v.ResourceDiskSizeInMB)
+			}
+
+			sizes = append(sizes, size)
+		}
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i].(map[string]interface{})["name"].(string) < sizes[j].(map[string]interface{})["name"].(string)
+	})
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("location", loc)
+
+	if err := d.Set("sizes", sizes); err != nil {
+		return fmt.Errorf("setting `sizes`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineSizesDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineSizes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.name").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.memory_in_mb").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.number_of_cores").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineSizesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,414 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualMachineSkus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVirtualMachineSkusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": location.Schema(),
+
+			"minimum_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"maximum_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum_memory_gb": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+
+			"maximum_memory_gb": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+
+			"accelerated_networking_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ephemeral_os_disk_supported": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"include_restricted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"skus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"accelerated_networking_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"ephemeral_os_disk_supported": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"memory_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"restricted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"restriction_reasons": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineSkusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ResourceSkusClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+
+	// NOTE: the API only supports filtering by location
+	filter := fmt.Sprintf("location eq '%s'", loc)
+	iterator, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("listing Compute SKUs for location %q: %+v", loc, err)
+	}
+
+	skus := make([]compute.ResourceSku, 0)
+	for iterator.NotDone() {
+		skus = append(skus, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Compute SKUs for location %q: %+v", loc, err)
+		}
+	}
+
+	skuFilter := virtualMachineSkuFilter{
+		location:                     loc,
+		minimumVCPUs:                 d.Get("minimum_vcpus").(int),
+		maximumVCPUs:                 d.Get("maximum_vcpus").(int),
+		minimumMemoryGB:              d.Get("minimum_memory_gb").(float64),
+		maximumMemoryGB:              d.Get("maximum_memory_gb").(float64),
+		acceleratedNetworkingEnabled: d.Get("accelerated_networking_enabled").(bool),
+		ephemeralOSDiskSupported:     d.Get("ephemeral_os_disk_supported").(bool),
+		includeRestricted:            d.Get("include_restricted").(bool),
+	}
+	for _, v := range d.Get("zones").([]interface{}) {
+		skuFilter.zones = append(skuFilter.zones, v.(string))
+	}
+
+	results := filterVirtualMachineSkus(skus, skuFilter)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("location", loc)
+
+	names := make([]interface{}, 0)
+	output := make([]interface{}, 0)
+	for _, v := range results {
+		names = append(names, v.name)
+		output = append(output, map[string]interface{}{
+			"name":                           v.name,
+			"accelerated_networking_enabled": v.acceleratedNetworkingEnabled,
+			"ephemeral_os_disk_supported":    v.ephemeralOSDiskSupported,
+			"family":                         v.family,
+			"memory_gb":                      v.memoryGB,
+			"restricted":                     v.restricted,
+			"restriction_reasons":            v.restrictionReasons,
+			"size":                           v.size,
+			"tier":                           v.tier,
+			"vcpus":                          v.vCPUs,
+			"zones":                          v.zones,
+		})
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("setting `names`: %+v", err)
+	}
+
+	if err := d.Set("skus", output); err != nil {
+		return fmt.Errorf("setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+type virtualMachineSkuFilter struct {
+	location                     string
+	minimumVCPUs                 int
+	maximumVCPUs                 int
+	minimumMemoryGB              float64
+	maximumMemoryGB              float64
+	acceleratedNetworkingEnabled bool
+	ephemeralOSDiskSupported     bool
+	zones                        []string
+	includeRestricted            bool
+}
+
+type virtualMachineSku struct {
+	name                         string
+	acceleratedNetworkingEnabled bool
+	ephemeralOSDiskSupported     bool
+	family                       string
+	memoryGB                     float64
+	restricted                   bool
+	restrictionReasons           []string
+	size                         string
+	tier                         string
+	vCPUs                        int
+	zones                        []string
+}
+
+// filterVirtualMachineSkus returns the Virtual Machine SKUs available in the specified location
+// which match the filter, sorted by name
+func filterVirtualMachineSkus(input []compute.ResourceSku, filter virtualMachineSkuFilter) []virtualMachineSku {
+	output := make([]virtualMachineSku, 0)
+
+	for _, item := range input {
+		if item.ResourceType == nil || !strings.EqualFold(*item.ResourceType, "virtualMachines") || item.Name == nil {
+			continue
+		}
+
+		sku, ok := parseVirtualMachineSku(item, filter.location)
+		if !ok {
+			continue
+		}
+
+		if sku.restricted && !filter.includeRestricted {
+			continue
+		}
+		if filter.minimumVCPUs > 0 && sku.vCPUs < filter.minimumVCPUs {
+			continue
+		}
+		if filter.maximumVCPUs > 0 && sku.vCPUs > filter.maximumVCPUs {
+			continue
+		}
+		if filter.minimumMemoryGB > 0 && sku.memoryGB < filter.minimumMemoryGB {
+			continue
+		}
+		if filter.maximumMemoryGB > 0 && sku.memoryGB > filter.maximumMemoryGB {
+			continue
+		}
+		if filter.acceleratedNetworkingEnabled && !sku.acceleratedNetworkingEnabled {
+			continue
+		}
+		if filter.ephemeralOSDiskSupported && !sku.ephemeralOSDiskSupported {
+			continue
+		}
+		if !containsAllZones(sku.zones, filter.zones) {
+			continue
+		}
+
+		output = append(output, sku)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].name < output[j].name
+	})
+
+	return output
+}
+
+// parseVirtualMachineSku parses the capabilities, zones and restrictions for the SKU within the specified
+// location - returning false if the SKU isn't offered in this location
+func parseVirtualMachineSku(input compute.ResourceSku, loc string) (virtualMachineSku, bool) {
+	output := virtualMachineSku{
+		name:               *input.Name,
+		restrictionReasons: make([]string, 0),
+		zones:              make([]string, 0),
+	}
+
+	if input.Family != nil {
+		output.family = *input.Family
+	}
+	if input.Size != nil {
+		output.size = *input.Size
+	}
+	if input.Tier != nil {
+		output.tier = *input.Tier
+	}
+
+	offered := false
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if info.Location == nil || location.Normalize(*info.Location) != loc {
+				continue
+			}
+
+			offered = true
+			if info.Zones != nil {
+				output.zones = append(output.zones, *info.Zones...)
+			}
+		}
+	}
+	if !offered {
+		return output, false
+	}
+
+	if input.Capabilities != nil {
+		for _, capability := range *input.Capabilities {
+			if capability.Name == nil || capability.Value == nil {
+				continue
+			}
+
+			value := *capability.Value
+			switch *capability.Name {
+			case "AcceleratedNetworkingEnabled":
+				output.acceleratedNetworkingEnabled = strings.EqualFold(value, "true")
+			case "EphemeralOSDiskSupported":
+				output.ephemeralOSDiskSupported = strings.EqualFold(value, "true")
+			case "MemoryGB":
+				if v, err := strconv.ParseFloat(value, 64); err == nil {
+					output.memoryGB = v
+				}
+			case "vCPUs":
+				if v, err := strconv.Atoi(value); err == nil {
+					output.vCPUs = v
+				}
+			}
+		}
+	}
+
+	restrictedZones := make(map[string]struct{})
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			switch restriction.Type {
+			case compute.Location:
+				// a Location restriction means this SKU can't be used in this location by this Subscription
+				output.restricted = true
+				output.restrictionReasons = append(output.restrictionReasons, string(restriction.ReasonCode))
+
+			case compute.Zone:
+				if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+					for _, zone := range *info.Zones {
+						restrictedZones[zone] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	availableZones := make([]string, 0)
+	for _, zone := range output.zones {
+		if _, restricted := restrictedZones[zone]; !restricted {
+			availableZones = append(availableZones, zone)
+		}
+	}
+	sort.Strings(availableZones)
+	output.zones = availableZones
+
+	return output, true
+}
+
+func containsAllZones(available []string, required []string) bool {
+	for _, zone := range required {
+		found := false
+		for _, v := range available {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
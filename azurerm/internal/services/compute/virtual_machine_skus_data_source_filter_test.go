@@ -0,0 +1,213 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFilterVirtualMachineSkus(t *testing.T) {
+	sku := func(name string, vCPUs, memoryGB, acceleratedNetworking, ephemeralOSDisk string, zones []string, restrictions []compute.ResourceSkuRestrictions) compute.ResourceSku {
+		return compute.ResourceSku{
+			ResourceType: utils.String("virtualMachines"),
+			Name:         utils.String(name),
+			Tier:         utils.String("Standard"),
+			Size:         utils.String(name[len("Standard_"):]),
+			Family:       utils.String("standardFamily"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: utils.String("WestEurope"),
+					Zones:    &zones,
+				},
+			},
+			Capabilities: &[]compute.ResourceSkuCapabilities{
+				{Name: utils.String("vCPUs"), Value: utils.String(vCPUs)},
+				{Name: utils.String("MemoryGB"), Value: utils.String(memoryGB)},
+				{Name: utils.String("AcceleratedNetworkingEnabled"), Value: utils.String(acceleratedNetworking)},
+				{Name: utils.String("EphemeralOSDiskSupported"), Value: utils.String(ephemeralOSDisk)},
+			},
+			Restrictions: &restrictions,
+		}
+	}
+
+	input := []compute.ResourceSku{
+		sku("Standard_D2s_v3", "2", "8", "True", "True", []string{"3", "1", "2"}, nil),
+		sku("Standard_B1s", "1", "1", "False", "False", []string{"1", "2", "3"}, nil),
+		sku("Standard_F4s_v2", "4", "8", "True", "True", []string{"1", "2", "3"}, []compute.ResourceSkuRestrictions{
+			{
+				Type: compute.Zone,
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+					Locations: &[]string{"westeurope"},
+					Zones:     &[]string{"3"},
+				},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		}),
+		sku("Standard_M8ms", "8", "218.75", "True", "False", []string{"1"}, []compute.ResourceSkuRestrictions{
+			{
+				Type:       compute.Location,
+				Values:     &[]string{"westeurope"},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		}),
+		{
+			// not a Virtual Machine SKU
+			ResourceType: utils.String("disks"),
+			Name:         utils.String("Premium_LRS"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: utils.String("westeurope"),
+				},
+			},
+		},
+		{
+			// not available in this location
+			ResourceType: utils.String("virtualMachines"),
+			Name:         utils.String("Standard_NV6"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: utils.String("eastus"),
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Filter   virtualMachineSkuFilter
+		Expected []string
+	}{
+		{
+			Name:     "no filters",
+			Filter:   virtualMachineSkuFilter{},
+			Expected: []string{"Standard_B1s", "Standard_D2s_v3", "Standard_F4s_v2"},
+		},
+		{
+			Name: "include restricted",
+			Filter: virtualMachineSkuFilter{
+				includeRestricted: true,
+			},
+			Expected: []string{"Standard_B1s", "Standard_D2s_v3", "Standard_F4s_v2", "Standard_M8ms"},
+		},
+		{
+			Name: "vcpu range",
+			Filter: virtualMachineSkuFilter{
+				minimumVCPUs: 2,
+				maximumVCPUs: 4,
+			},
+			Expected: []string{"Standard_D2s_v3", "Standard_F4s_v2"},
+		},
+		{
+			Name: "memory range",
+			Filter: virtualMachineSkuFilter{
+				minimumMemoryGB:   8,
+				maximumMemoryGB:   256,
+				includeRestricted: true,
+			},
+			Expected: []string{"Standard_D2s_v3", "Standard_F4s_v2", "Standard_M8ms"},
+		},
+		{
+			Name: "accelerated networking",
+			Filter: virtualMachineSkuFilter{
+				acceleratedNetworkingEnabled: true,
+			},
+			Expected: []string{"Standard_D2s_v3", "Standard_F4s_v2"},
+		},
+		{
+			Name: "ephemeral os disk",
+			Filter: virtualMachineSkuFilter{
+				ephemeralOSDiskSupported: true,
+				maximumVCPUs:             2,
+			},
+			Expected: []string{"Standard_D2s_v3"},
+		},
+		{
+			Name: "zone restricted for subscription",
+			Filter: virtualMachineSkuFilter{
+				zones: []string{"1", "3"},
+			},
+			Expected: []string{"Standard_B1s", "Standard_D2s_v3"},
+		},
+		{
+			Name: "zone available",
+			Filter: virtualMachineSkuFilter{
+				zones: []string{"2"},
+			},
+			Expected: []string{"Standard_B1s", "Standard_D2s_v3", "Standard_F4s_v2"},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		v.Filter.location = "westeurope"
+		actual := make([]string, 0)
+		for _, sku := range filterVirtualMachineSkus(input, v.Filter) {
+			actual = append(actual, sku.name)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseVirtualMachineSku(t *testing.T) {
+	input := compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String("Standard_F4s_v2"),
+		Tier:         utils.String("Standard"),
+		Size:         utils.String("F4s_v2"),
+		Family:       utils.String("standardFSv2Family"),
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("westeurope"),
+				Zones:    &[]string{"3", "1", "2"},
+			},
+		},
+		Capabilities: &[]compute.ResourceSkuCapabilities{
+			{Name: utils.String("vCPUs"), Value: utils.String("4")},
+			{Name: utils.String("MemoryGB"), Value: utils.String("8")},
+			{Name: utils.String("AcceleratedNetworkingEnabled"), Value: utils.String("True")},
+			{Name: utils.String("EphemeralOSDiskSupported"), Value: utils.String("True")},
+			{Name: utils.String("MaxDataDiskCount"), Value: utils.String("8")},
+		},
+		Restrictions: &[]compute.ResourceSkuRestrictions{
+			{
+				Type: compute.Zone,
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+					Zones: &[]string{"2"},
+				},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		},
+	}
+
+	expected := virtualMachineSku{
+		name:                         "Standard_F4s_v2",
+		acceleratedNetworkingEnabled: true,
+		ephemeralOSDiskSupported:     true,
+		family:                       "standardFSv2Family",
+		memoryGB:                     8,
+		restricted:                   false,
+		restrictionReasons:           []string{},
+		size:                         "F4s_v2",
+		tier:                         "Standard",
+		vCPUs:                        4,
+		zones:                        []string{"1", "3"},
+	}
+
+	actual, ok := parseVirtualMachineSku(input, "westeurope")
+	if !ok {
+		t.Fatalf("Expected the SKU to be offered in `westeurope` but it wasn't")
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if _, ok := parseVirtualMachineSku(input, "eastus"); ok {
+		t.Fatalf("Expected the SKU not to be offered in `eastus` but it was")
+	}
+}
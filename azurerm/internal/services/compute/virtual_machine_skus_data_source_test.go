@@ -0,0 +1,75 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineSkusDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineSkus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_skus", "test")
+	r := VirtualMachineSkusDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").Exists(),
+				check.That(data.ResourceName).Key("skus.0.name").Exists(),
+				check.That(data.ResourceName).Key("skus.0.vcpus").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineSkus_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_skus", "test")
+	r := VirtualMachineSkusDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.0.vcpus").HasValue("2"),
+				check.That(data.ResourceName).Key("skus.0.accelerated_networking_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("skus.0.ephemeral_os_disk_supported").HasValue("true"),
+				check.That(data.ResourceName).Key("skus.0.restricted").HasValue("false"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineSkusDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_skus" "test" {
+  location                       = "%s"
+  minimum_vcpus                  = 2
+  maximum_vcpus                  = 2
+  minimum_memory_gb              = 4
+  accelerated_networking_enabled = true
+  ephemeral_os_disk_supported    = true
+}
+`, data.Locations.Primary)
+}
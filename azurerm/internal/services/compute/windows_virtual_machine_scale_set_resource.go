@@ -221,6 +221,10 @@ func resourceWindowsVirtualMachineScaleSet() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			// this only controls how the provider rolls the instances and isn't returned by the API, so it's never set in the Read
+			//lintignore:resourcereadset
+			"rolling_upgrade_orchestration": VirtualMachineScaleSetRollingUpgradeOrchestrationSchema(),
+
 			"rolling_upgrade_policy": VirtualMachineScaleSetRollingUpgradePolicySchema(),
 
 			"secret": windowsSecretSchema(),
@@ -289,7 +293,17 @@ func resourceWindowsVirtualMachineScaleSet() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceWindowsVirtualMachineScaleSetCustomizeDiff,
+	}
+}
+
+func resourceWindowsVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("upgrade_mode") || !d.NewValueKnown("rolling_upgrade_orchestration") {
+		return nil
 	}
+
+	return ValidateVirtualMachineScaleSetRollingUpgradeOrchestration(compute.UpgradeMode(d.Get("upgrade_mode").(string)), d.Get("rolling_upgrade_orchestration").([]interface{}))
 }
 
 func resourceWindowsVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
@@ -852,6 +866,7 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta in
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
+		RollingUpgradeOrchestration:  ExpandVirtualMachineScaleSetRollingUpgradeOrchestration(d.Get("rolling_upgrade_orchestration").([]interface{})),
 		UpdateInstances:              updateInstances,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
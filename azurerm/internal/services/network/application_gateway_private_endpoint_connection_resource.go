@@ -0,0 +1,229 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Private Endpoint Connections are created by the owner of the Private Endpoint rather than the Application Gateway,
+// so this resource manages the approval of an existing connection rather than the connection itself
+func resourceApplicationGatewayPrivateEndpointConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationGatewayPrivateEndpointConnectionCreate,
+		Read:   resourceApplicationGatewayPrivateEndpointConnectionRead,
+		Update: resourceApplicationGatewayPrivateEndpointConnectionUpdate,
+		Delete: resourceApplicationGatewayPrivateEndpointConnectionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ApplicationGatewayPrivateEndpointConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayID,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Approved",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Approved",
+					"Rejected",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"private_endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"link_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayPrivateEndpointConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewayPrivateEndpointConnectionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayPrivateEndpointConnectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found - the Private Endpoint must be created before the connection can be approved", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the connection always exists before it's managed and Azure doesn't track who approved/rejected it, so there's
+	// no way to tell whether it's already managed elsewhere - as such the existing connection is adopted
+	if err := updateApplicationGatewayPrivateEndpointConnectionState(ctx, client, id, existing, d.Get("status").(string), d.Get("description").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayPrivateEndpointConnectionRead(d, meta)
+}
+
+func resourceApplicationGatewayPrivateEndpointConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewayPrivateEndpointConnectionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayPrivateEndpointConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if err := updateApplicationGatewayPrivateEndpointConnectionState(ctx, client, *id, existing, d.Get("status").(string), d.Get("description").(string)); err != nil {
+		return err
+	}
+
+	return resourceApplicationGatewayPrivateEndpointConnectionRead(d, meta)
+}
+
+func resourceApplicationGatewayPrivateEndpointConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewayPrivateEndpointConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayPrivateEndpointConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PrivateEndpointConnectionName)
+	d.Set("application_gateway_id", parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName).ID())
+
+	if props := resp.ApplicationGatewayPrivateEndpointConnectionProperties; props != nil {
+		privateEndpointId := ""
+		if props.PrivateEndpoint != nil && props.PrivateEndpoint.ID != nil {
+			privateEndpointId = *props.PrivateEndpoint.ID
+		}
+		d.Set("private_endpoint_id", privateEndpointId)
+		d.Set("link_identifier", props.LinkIdentifier)
+
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			d.Set("status", state.Status)
+			d.Set("description", state.Description)
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayPrivateEndpointConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewayPrivateEndpointConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayPrivateEndpointConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func updateApplicationGatewayPrivateEndpointConnectionState(ctx context.Context, client *network.ApplicationGatewayPrivateEndpointConnectionsClient, id parse.ApplicationGatewayPrivateEndpointConnectionId, existing network.ApplicationGatewayPrivateEndpointConnection, status, description string) error {
+	if existing.ApplicationGatewayPrivateEndpointConnectionProperties == nil {
+		existing.ApplicationGatewayPrivateEndpointConnectionProperties = &network.ApplicationGatewayPrivateEndpointConnectionProperties{}
+	}
+	existing.ApplicationGatewayPrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState = &network.PrivateLinkServiceConnectionState{
+		Status:      utils.String(status),
+		Description: utils.String(description),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName, existing)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
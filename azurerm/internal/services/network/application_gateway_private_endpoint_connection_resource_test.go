@@ -0,0 +1,132 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ApplicationGatewayPrivateEndpointConnectionResource struct {
+}
+
+func TestAccApplicationGatewayPrivateEndpointConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_private_endpoint_connection", "test")
+	r := ApplicationGatewayPrivateEndpointConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("private_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayPrivateEndpointConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_private_endpoint_connection", "test")
+	r := ApplicationGatewayPrivateEndpointConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rejected(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+				check.That(data.ResourceName).Key("description").HasValue("Rejected by Terraform"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApplicationGatewayPrivateEndpointConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayPrivateEndpointConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewayPrivateEndpointConnectionsClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ApplicationGatewayPrivateEndpointConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_private_endpoint_connection" "test" {
+  name                   = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_name
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+`, r.template(data))
+}
+
+func (r ApplicationGatewayPrivateEndpointConnectionResource) rejected(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_private_endpoint_connection" "test" {
+  name                   = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_name
+  application_gateway_id = azurerm_application_gateway.test.id
+  status                 = "Rejected"
+  description            = "Rejected by Terraform"
+}
+`, r.template(data))
+}
+
+func (ApplicationGatewayPrivateEndpointConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "endpoint" {
+  name                                           = "subnet-pe-%d"
+  resource_group_name                            = azurerm_resource_group.test.name
+  virtual_network_name                           = azurerm_virtual_network.test.name
+  address_prefix                                 = "10.0.2.0/24"
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-pe-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = "acctest-psc-%d"
+    is_manual_connection           = true
+    private_connection_resource_id = azurerm_application_gateway.test.id
+    subresource_names              = [local.frontend_ip_configuration_name]
+    request_message                = "Please approve"
+  }
+}
+
+data "azurerm_private_link_service_endpoint_connections" "test" {
+  service_id          = azurerm_private_endpoint.test.private_service_connection.0.private_connection_resource_id
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, ApplicationGatewayResource{}.privateLink(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
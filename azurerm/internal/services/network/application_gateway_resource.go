@@ -22,6 +22,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const applicationGatewayResourceName = "azurerm_application_gateway"
+
 // See https://github.com/Azure/azure-sdk-for-go/blob/master/services/network/mgmt/2018-04-01/network/models.go
 func possibleApplicationGatewaySslCipherSuiteValues() []string {
 	cipherSuites := make([]string, 0)
@@ -309,10 +311,21 @@ func resourceApplicationGateway() *schema.Resource {
 							}, true),
 						},
 
+						"private_link_configuration_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
 						"id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"private_link_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -803,6 +816,67 @@ func resourceApplicationGateway() *schema.Resource {
 				Optional: true,
 			},
 
+			"private_link_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ip_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"subnet_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"private_ip_address_allocation": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.Dynamic),
+											string(network.Static),
+										}, false),
+									},
+
+									"primary": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+
+									"private_ip_address": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsIPv4Address,
+									},
+								},
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"probe": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1392,10 +1466,11 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, gatewayID),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
-			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
+			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d, gatewayID),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
+			PrivateLinkConfigurations:     expandApplicationGatewayPrivateLinkConfigurations(d),
 			Probes:                        expandApplicationGatewayProbes(d),
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
@@ -1580,10 +1655,18 @@ func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error setting `frontend_port`: %+v", setErr)
 		}
 
-		if setErr := d.Set("frontend_ip_configuration", flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)); setErr != nil {
+		frontendIPConfigurations, err := flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)
+		if err != nil {
+			return fmt.Errorf("Error flattening `frontend_ip_configuration`: %+v", err)
+		}
+		if setErr := d.Set("frontend_ip_configuration", frontendIPConfigurations); setErr != nil {
 			return fmt.Errorf("Error setting `frontend_ip_configuration`: %+v", setErr)
 		}
 
+		if setErr := d.Set("private_link_configuration", flattenApplicationGatewayPrivateLinkConfigurations(props.PrivateLinkConfigurations)); setErr != nil {
+			return fmt.Errorf("Error setting `private_link_configuration`: %+v", setErr)
+		}
+
 		if setErr := d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(props.GatewayIPConfigurations)); setErr != nil {
 			return fmt.Errorf("Error setting `gateway_ip_configuration`: %+v", setErr)
 		}
@@ -2512,7 +2595,7 @@ func flattenApplicationGatewayFrontendPorts(input *[]network.ApplicationGatewayF
 	return results
 }
 
-func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayFrontendIPConfiguration {
+func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayFrontendIPConfiguration {
 	vs := d.Get("frontend_ip_configuration").([]interface{})
 	results := make([]network.ApplicationGatewayFrontendIPConfiguration, 0)
 
@@ -2541,6 +2624,13 @@ func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData) *[
 			}
 		}
 
+		if val := v["private_link_configuration_name"].(string); val != "" {
+			privateLinkConfigurationID := fmt.Sprintf("%s/privateLinkConfigurations/%s", gatewayID, val)
+			properties.PrivateLinkConfiguration = &network.SubResource{
+				ID: utils.String(privateLinkConfigurationID),
+			}
+		}
+
 		name := v["name"].(string)
 		output := network.ApplicationGatewayFrontendIPConfiguration{
 			Name: utils.String(name),
@@ -2553,10 +2643,10 @@ func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData) *[
 	return &results
 }
 
-func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.ApplicationGatewayFrontendIPConfiguration) []interface{} {
+func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.ApplicationGatewayFrontendIPConfiguration) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
-		return results
+		return results, nil
 	}
 
 	for _, config := range *input {
@@ -2583,7 +2673,107 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
 				output["public_ip_address_id"] = *props.PublicIPAddress.ID
 			}
+
+			if props.PrivateLinkConfiguration != nil && props.PrivateLinkConfiguration.ID != nil {
+				privateLinkConfigurationId, err := azure.ParseAzureResourceID(*props.PrivateLinkConfiguration.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["private_link_configuration_name"] = privateLinkConfigurationId.Path["privateLinkConfigurations"]
+				output["private_link_configuration_id"] = *props.PrivateLinkConfiguration.ID
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func expandApplicationGatewayPrivateLinkConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayPrivateLinkConfiguration {
+	vs := d.Get("private_link_configuration").([]interface{})
+	results := make([]network.ApplicationGatewayPrivateLinkConfiguration, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		ipConfigurations := make([]network.ApplicationGatewayPrivateLinkIPConfiguration, 0)
+		for _, configRaw := range v["ip_configuration"].([]interface{}) {
+			config := configRaw.(map[string]interface{})
+
+			properties := network.ApplicationGatewayPrivateLinkIPConfigurationProperties{
+				PrivateIPAllocationMethod: network.IPAllocationMethod(config["private_ip_address_allocation"].(string)),
+				Primary:                   utils.Bool(config["primary"].(bool)),
+				Subnet: &network.SubResource{
+					ID: utils.String(config["subnet_id"].(string)),
+				},
+			}
+
+			if val := config["private_ip_address"].(string); val != "" {
+				properties.PrivateIPAddress = utils.String(val)
+			}
+
+			ipConfigurations = append(ipConfigurations, network.ApplicationGatewayPrivateLinkIPConfiguration{
+				Name: utils.String(config["name"].(string)),
+				ApplicationGatewayPrivateLinkIPConfigurationProperties: &properties,
+			})
+		}
+
+		results = append(results, network.ApplicationGatewayPrivateLinkConfiguration{
+			Name: utils.String(v["name"].(string)),
+			ApplicationGatewayPrivateLinkConfigurationProperties: &network.ApplicationGatewayPrivateLinkConfigurationProperties{
+				IPConfigurations: &ipConfigurations,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.ApplicationGatewayPrivateLinkConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := make(map[string]interface{})
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		ipConfigurations := make([]interface{}, 0)
+		if props := config.ApplicationGatewayPrivateLinkConfigurationProperties; props != nil && props.IPConfigurations != nil {
+			for _, ipConfig := range *props.IPConfigurations {
+				ipOutput := make(map[string]interface{})
+				if ipConfig.Name != nil {
+					ipOutput["name"] = *ipConfig.Name
+				}
+
+				if ipProps := ipConfig.ApplicationGatewayPrivateLinkIPConfigurationProperties; ipProps != nil {
+					ipOutput["private_ip_address_allocation"] = string(ipProps.PrivateIPAllocationMethod)
+
+					if ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
+						ipOutput["subnet_id"] = *ipProps.Subnet.ID
+					}
+
+					if ipProps.PrivateIPAddress != nil {
+						ipOutput["private_ip_address"] = *ipProps.PrivateIPAddress
+					}
+
+					if ipProps.Primary != nil {
+						ipOutput["primary"] = *ipProps.Primary
+					}
+				}
+
+				ipConfigurations = append(ipConfigurations, ipOutput)
+			}
 		}
+		output["ip_configuration"] = ipConfigurations
 
 		results = append(results, output)
 	}
@@ -930,6 +930,24 @@ func TestAccApplicationGateway_backendAddressPoolEmptyIpList(t *testing.T) {
 	})
 }
 
+func TestAccApplicationGateway_privateLink(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.privateLink(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_link_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_link_configuration.0.id").Exists(),
+				check.That(data.ResourceName).Key("frontend_ip_configuration.0.private_link_configuration_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ApplicationGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -5658,3 +5676,102 @@ resource "azurerm_application_gateway" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r ApplicationGatewayResource) privateLink(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+# since these variables are re-used - a locals block makes this more maintainable
+locals {
+  backend_address_pool_name       = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name              = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name  = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name               = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                   = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name       = "${azurerm_virtual_network.test.name}-rqrt"
+  private_link_configuration_name = "${azurerm_virtual_network.test.name}-pvtlink"
+}
+
+resource "azurerm_subnet" "private_link" {
+  name                                          = "subnet-pl-%d"
+  resource_group_name                           = azurerm_resource_group.test.name
+  virtual_network_name                          = azurerm_virtual_network.test.name
+  address_prefix                                = "10.0.1.0/24"
+  enforce_private_link_service_network_policies = true
+}
+
+resource "azurerm_public_ip" "test_standard" {
+  name                = "acctest-pubip-%d-standard"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  allocation_method   = "Static"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_v2"
+    tier     = "Standard_v2"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                            = local.frontend_ip_configuration_name
+    public_ip_address_id            = azurerm_public_ip.test_standard.id
+    private_link_configuration_name = local.private_link_configuration_name
+  }
+
+  private_link_configuration {
+    name = local.private_link_configuration_name
+
+    ip_configuration {
+      name                          = "primary"
+      subnet_id                     = azurerm_subnet.private_link.id
+      private_ip_address_allocation = "Dynamic"
+      primary                       = true
+    }
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
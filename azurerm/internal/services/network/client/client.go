@@ -6,55 +6,68 @@ import (
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                          *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient                    *network.ApplicationSecurityGroupsClient
+	BastionHostsClient                                 *network.BastionHostsClient
+	ConnectionMonitorsClient                           *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient                          *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                            *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient                         *network.ExpressRouteCircuitsClient
+	ExpressRouteGatewaysClient                         *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient                         *network.ExpressRouteCircuitPeeringsClient
+	HubRouteTableClient                                *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient                  *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                                   *network.InterfacesClient
+	IPGroupsClient                                     *network.IPGroupsClient
+	LocalNetworkGatewaysClient                         *network.LocalNetworkGatewaysClient
+	PointToSiteVpnGatewaysClient                       *network.P2sVpnGatewaysClient
+	ProfileClient                                      *network.ProfilesClient
+	PacketCapturesClient                               *network.PacketCapturesClient
+	PrivateEndpointClient                              *network.PrivateEndpointsClient
+	PublicIPsClient                                    *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                             *network.PublicIPPrefixesClient
+	RoutesClient                                       *network.RoutesClient
+	RouteFiltersClient                                 *network.RouteFiltersClient
+	RouteTablesClient                                  *network.RouteTablesClient
+	SecurityGroupClient                                *network.SecurityGroupsClient
+	SecurityPartnerProviderClient                      *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                                 *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient                      *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient             *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                                  *network.ServiceTagsClient
+	SubnetsClient                                      *network.SubnetsClient
+	NatGatewayClient                                   *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient                      *network.VirtualHubBgpConnectionClient
+	VirtualHubIPClient                                 *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient                       *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                                  *network.VirtualNetworkGatewaysClient
+	VnetClient                                         *network.VirtualNetworksClient
+	VnetPeeringsClient                                 *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                                   *network.VirtualWansClient
+	VirtualHubClient                                   *network.VirtualHubsClient
+	VpnConnectionsClient                               *network.VpnConnectionsClient
+	VpnGatewaysClient                                  *network.VpnGatewaysClient
+	VpnServerConfigurationsClient                      *network.VpnServerConfigurationsClient
+	VpnSitesClient                                     *network.VpnSitesClient
+	WatcherClient                                      *network.WatchersClient
+	WebApplicationFirewallPoliciesClient               *network.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                          *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                           *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient                       *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient                       *network.ResourceNavigationLinksClient
+	VirtualRoutersClient                               *network.VirtualRoutersClient
+	VirtualRouterPeeringsClient                        *network.VirtualRouterPeeringsClient
+	VirtualNetworkTapsClient                           *network.VirtualNetworkTapsClient
+	InterfaceTapConfigurationsClient                   *network.InterfaceTapConfigurationsClient
+	ExpressRoutePortsClient                            *network.ExpressRoutePortsClient
+	ExpressRouteCircuitConnectionsClient               *network.ExpressRouteCircuitConnectionsClient
+	DDOSCustomPoliciesClient                           *network.DdosCustomPoliciesClient
+	VirtualAppliancesClient                            *network.VirtualAppliancesClient
+	VirtualApplianceSitesClient                        *network.VirtualApplianceSitesClient
+	VirtualApplianceSkusClient                         *network.VirtualApplianceSkusClient
+	ApplicationGatewayPrivateEndpointConnectionsClient *network.ApplicationGatewayPrivateEndpointConnectionsClient
+	VpnLinkConnectionsClient                           *network.VpnLinkConnectionsClient
+	VpnSitesConfigurationClient                        *network.VpnSitesConfigurationClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -205,55 +218,107 @@ func NewClient(o *common.ClientOptions) *Client {
 	ResourceNavigationLinkClient := network.NewResourceNavigationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualRoutersClient := network.NewVirtualRoutersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualRoutersClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualRouterPeeringsClient := network.NewVirtualRouterPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualRouterPeeringsClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualNetworkTapsClient := network.NewVirtualNetworkTapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualNetworkTapsClient.Client, o.ResourceManagerAuthorizer)
+
+	InterfaceTapConfigurationsClient := network.NewInterfaceTapConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&InterfaceTapConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRoutePortsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteCircuitConnectionsClient := network.NewExpressRouteCircuitConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteCircuitConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	DDOSCustomPoliciesClient := network.NewDdosCustomPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DDOSCustomPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualAppliancesClient := network.NewVirtualAppliancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualAppliancesClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualApplianceSitesClient := network.NewVirtualApplianceSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualApplianceSitesClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualApplianceSkusClient := network.NewVirtualApplianceSkusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualApplianceSkusClient.Client, o.ResourceManagerAuthorizer)
+
+	ApplicationGatewayPrivateEndpointConnectionsClient := network.NewApplicationGatewayPrivateEndpointConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ApplicationGatewayPrivateEndpointConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	vpnLinkConnectionsClient := network.NewVpnLinkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vpnLinkConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	vpnSitesConfigurationClient := network.NewVpnSitesConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vpnSitesConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                          &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:                    &ApplicationSecurityGroupsClient,
+		BastionHostsClient:                                 &BastionHostsClient,
+		ConnectionMonitorsClient:                           &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:                          &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                            &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:                         &ExpressRouteCircuitsClient,
+		ExpressRouteGatewaysClient:                         &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:                         &ExpressRoutePeeringsClient,
+		HubRouteTableClient:                                &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:                  &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                                   &InterfacesClient,
+		IPGroupsClient:                                     &IpGroupsClient,
+		LocalNetworkGatewaysClient:                         &LocalNetworkGatewaysClient,
+		PointToSiteVpnGatewaysClient:                       &pointToSiteVpnGatewaysClient,
+		ProfileClient:                                      &ProfileClient,
+		PacketCapturesClient:                               &PacketCapturesClient,
+		PrivateEndpointClient:                              &PrivateEndpointClient,
+		PublicIPsClient:                                    &PublicIPsClient,
+		PublicIPPrefixesClient:                             &PublicIPPrefixesClient,
+		RoutesClient:                                       &RoutesClient,
+		RouteFiltersClient:                                 &RouteFiltersClient,
+		RouteTablesClient:                                  &RouteTablesClient,
+		SecurityGroupClient:                                &SecurityGroupClient,
+		SecurityPartnerProviderClient:                      &SecurityPartnerProviderClient,
+		SecurityRuleClient:                                 &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:                      &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:             &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                                  &ServiceTagsClient,
+		SubnetsClient:                                      &SubnetsClient,
+		NatGatewayClient:                                   &NatGatewayClient,
+		VirtualHubBgpConnectionClient:                      &VirtualHubBgpConnectionClient,
+		VirtualHubIPClient:                                 &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:                       &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                                  &VnetGatewayClient,
+		VnetClient:                                         &VnetClient,
+		VnetPeeringsClient:                                 &VnetPeeringsClient,
+		VirtualWanClient:                                   &VirtualWanClient,
+		VirtualHubClient:                                   &VirtualHubClient,
+		VpnConnectionsClient:                               &vpnConnectionsClient,
+		VpnGatewaysClient:                                  &vpnGatewaysClient,
+		VpnServerConfigurationsClient:                      &vpnServerConfigurationsClient,
+		VpnSitesClient:                                     &vpnSitesClient,
+		WatcherClient:                                      &WatcherClient,
+		WebApplicationFirewallPoliciesClient:               &WebApplicationFirewallPoliciesClient,
+		PrivateDnsZoneGroupClient:                          &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                           &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:                       &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:                       &ResourceNavigationLinkClient,
+		VirtualRoutersClient:                               &VirtualRoutersClient,
+		VirtualRouterPeeringsClient:                        &VirtualRouterPeeringsClient,
+		VirtualNetworkTapsClient:                           &VirtualNetworkTapsClient,
+		InterfaceTapConfigurationsClient:                   &InterfaceTapConfigurationsClient,
+		ExpressRoutePortsClient:                            &ExpressRoutePortsClient,
+		ExpressRouteCircuitConnectionsClient:               &ExpressRouteCircuitConnectionsClient,
+		DDOSCustomPoliciesClient:                           &DDOSCustomPoliciesClient,
+		VirtualAppliancesClient:                            &VirtualAppliancesClient,
+		VirtualApplianceSitesClient:                        &VirtualApplianceSitesClient,
+		VirtualApplianceSkusClient:                         &VirtualApplianceSkusClient,
+		ApplicationGatewayPrivateEndpointConnectionsClient: &ApplicationGatewayPrivateEndpointConnectionsClient,
+		VpnLinkConnectionsClient:                           &vpnLinkConnectionsClient,
+		VpnSitesConfigurationClient:                        &vpnSitesConfigurationClient,
 	}
 }
@@ -0,0 +1,168 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ExpressRouteCircuitConnectionResource struct {
+}
+
+func TestAccExpressRouteCircuitConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_connection", "test")
+	r := ExpressRouteCircuitConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("circuit_connection_status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRouteCircuitConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_connection", "test")
+	r := ExpressRouteCircuitConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t ExpressRouteCircuitConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ExpressRouteCircuitConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ExpressRouteCircuitConnectionsClient.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.ConnectionName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ExpressRouteCircuitConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit_connection" "test" {
+  name                = "acctest-ercc-%d"
+  peering_id          = azurerm_express_route_circuit_peering.test.id
+  peer_peering_id     = azurerm_express_route_circuit_peering.peer.id
+  address_prefix_ipv4 = "192.169.8.0/29"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRouteCircuitConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit_connection" "import" {
+  name                = azurerm_express_route_circuit_connection.test.name
+  peering_id          = azurerm_express_route_circuit_connection.test.peering_id
+  peer_peering_id     = azurerm_express_route_circuit_connection.test.peer_peering_id
+  address_prefix_ipv4 = azurerm_express_route_circuit_connection.test.address_prefix_ipv4
+}
+`, r.basic(data))
+}
+
+func (ExpressRouteCircuitConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ercc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  express_route_port_id = azurerm_express_route_port.test.id
+  bandwidth_in_gbps     = 5
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = azurerm_express_route_circuit.test.name
+  resource_group_name           = azurerm_resource_group.test.name
+  shared_key                    = "ItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+
+resource "azurerm_express_route_port" "peer" {
+  name                = "acctest-erp2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "CDC-Canberra"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_circuit" "peer" {
+  name                  = "acctest-erc2-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  express_route_port_id = azurerm_express_route_port.peer.id
+  bandwidth_in_gbps     = 5
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "peer" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = azurerm_express_route_circuit.peer.name
+  resource_group_name           = azurerm_resource_group.test.name
+  shared_key                    = "ItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
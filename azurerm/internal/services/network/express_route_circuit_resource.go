@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -39,6 +41,9 @@ func resourceExpressRouteCircuit() *schema.Resource {
 			customdiff.ForceNewIfChange("bandwidth_in_mbps", func(old, new, meta interface{}) bool {
 				return new.(int) < old.(int)
 			}),
+			customdiff.ForceNewIfChange("bandwidth_in_gbps", func(old, new, meta interface{}) bool {
+				return new.(float64) < old.(float64)
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -61,21 +66,41 @@ func resourceExpressRouteCircuit() *schema.Resource {
 
 			"service_provider_name": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				ExactlyOneOf:     []string{"service_provider_name", "express_route_port_id"},
+				RequiredWith:     []string{"peering_location", "bandwidth_in_mbps"},
 			},
 
 			"peering_location": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				RequiredWith:     []string{"service_provider_name"},
 			},
 
 			"bandwidth_in_mbps": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"service_provider_name"},
+			},
+
+			"express_route_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ExpressRoutePortID,
+				ExactlyOneOf: []string{"service_provider_name", "express_route_port_id"},
+				RequiredWith: []string{"bandwidth_in_gbps"},
+			},
+
+			"bandwidth_in_gbps": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				RequiredWith: []string{"express_route_port_id"},
 			},
 
 			"sku": {
@@ -144,6 +169,17 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 	locks.ByName(name, expressRouteCircuitResourceName)
 	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
 
+	// circuits created on an ExpressRoute Port consume its bandwidth, so changes to the Port must wait
+	if v := d.Get("express_route_port_id").(string); v != "" {
+		portId, err := parse.ExpressRoutePortID(v)
+		if err != nil {
+			return err
+		}
+
+		locks.ByName(portId.Name, expressRoutePortResourceName)
+		defer locks.UnlockByName(portId.Name, expressRoutePortResourceName)
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
@@ -161,6 +197,8 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 	serviceProviderName := d.Get("service_provider_name").(string)
 	peeringLocation := d.Get("peering_location").(string)
 	bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
+	expressRoutePortId := d.Get("express_route_port_id").(string)
+	bandwidthInGbps := d.Get("bandwidth_in_gbps").(float64)
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -202,14 +240,25 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.PeeringLocation = &peeringLocation
 			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.BandwidthInMbps = &bandwidthInMbps
 		}
+		if erc.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort != nil {
+			erc.ExpressRouteCircuitPropertiesFormat.BandwidthInGbps = &bandwidthInGbps
+		}
 	} else {
 		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{
 			AllowClassicOperations: &allowRdfeOps,
-			ServiceProviderProperties: &network.ExpressRouteCircuitServiceProviderProperties{
+		}
+
+		if expressRoutePortId != "" {
+			erc.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort = &network.SubResource{
+				ID: &expressRoutePortId,
+			}
+			erc.ExpressRouteCircuitPropertiesFormat.BandwidthInGbps = &bandwidthInGbps
+		} else {
+			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties = &network.ExpressRouteCircuitServiceProviderProperties{
 				ServiceProviderName: &serviceProviderName,
 				PeeringLocation:     &peeringLocation,
 				BandwidthInMbps:     &bandwidthInMbps,
-			},
+			}
 		}
 	}
 
@@ -293,6 +342,24 @@ func resourceExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("bandwidth_in_mbps", props.BandwidthInMbps)
 	}
 
+	if props := resp.ExpressRouteCircuitPropertiesFormat; props != nil {
+		expressRoutePortId := ""
+		if props.ExpressRoutePort != nil && props.ExpressRoutePort.ID != nil {
+			portId, err := parse.ExpressRoutePortID(*props.ExpressRoutePort.ID)
+			if err != nil {
+				return err
+			}
+			expressRoutePortId = portId.ID()
+		}
+		d.Set("express_route_port_id", expressRoutePortId)
+
+		bandwidthInGbps := 0.0
+		if props.BandwidthInGbps != nil {
+			bandwidthInGbps = *props.BandwidthInGbps
+		}
+		d.Set("bandwidth_in_gbps", bandwidthInGbps)
+	}
+
 	d.Set("service_provider_provisioning_state", string(resp.ServiceProviderProvisioningState))
 	d.Set("service_key", resp.ServiceKey)
 	d.Set("allow_classic_operations", resp.AllowClassicOperations)
@@ -0,0 +1,508 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const expressRoutePortResourceName = "azurerm_express_route_port"
+
+func resourceExpressRoutePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExpressRoutePortCreateUpdate,
+		Read:   resourceExpressRoutePortRead,
+		Update: resourceExpressRoutePortCreateUpdate,
+		Delete: resourceExpressRoutePortDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ExpressRoutePortID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"peering_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"bandwidth_in_gbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{10, 100}),
+			},
+
+			"encapsulation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dot1Q),
+					string(network.QinQ),
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"link1": schemaExpressRoutePortLink(),
+
+			"link2": schemaExpressRoutePortLink(),
+
+			"ethertype": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mtu": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func schemaExpressRoutePortLink() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"macsec_cipher": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(network.GcmAes128),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.GcmAes128),
+						string(network.GcmAes256),
+					}, false),
+				},
+
+				"macsec_ckn_keyvault_secret_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+
+				"macsec_cak_keyvault_secret_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"connector_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"interface_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"patch_panel_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"rack_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"router_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceExpressRoutePortCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewExpressRoutePortID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_port", id.ID())
+		}
+	}
+
+	locks.ByName(id.Name, expressRoutePortResourceName)
+	defer locks.UnlockByName(id.Name, expressRoutePortResourceName)
+
+	parameters := network.ExpressRoutePort{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Identity: expandExpressRoutePortIdentity(d.Get("identity").([]interface{})),
+		ExpressRoutePortPropertiesFormat: &network.ExpressRoutePortPropertiesFormat{
+			PeeringLocation: utils.String(d.Get("peering_location").(string)),
+			BandwidthInGbps: utils.Int32(int32(d.Get("bandwidth_in_gbps").(int))),
+			Encapsulation:   network.ExpressRoutePortsEncapsulation(d.Get("encapsulation").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// the Links are created by the service along with the Port, specifying their settings during the initial
+	// creation either fails (e.g. `adminState`) or is silently ignored (e.g. MACsec) - so these are set in an update
+	if !d.IsNewResource() {
+		parameters.ExpressRoutePortPropertiesFormat.Links = expandExpressRoutePortLinks(d)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	_, hasLink1 := d.GetOk("link1")
+	_, hasLink2 := d.GetOk("link2")
+	if d.IsNewResource() && (hasLink1 || hasLink2) {
+		parameters.ExpressRoutePortPropertiesFormat.Links = expandExpressRoutePortLinks(d)
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("updating the links for %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of the links for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceExpressRoutePortRead(d, meta)
+}
+
+func resourceExpressRoutePortRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	identity, err := flattenExpressRoutePortIdentity(resp.Identity)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ExpressRoutePortPropertiesFormat; props != nil {
+		d.Set("peering_location", props.PeeringLocation)
+
+		bandwidthInGbps := 0
+		if props.BandwidthInGbps != nil {
+			bandwidthInGbps = int(*props.BandwidthInGbps)
+		}
+		d.Set("bandwidth_in_gbps", bandwidthInGbps)
+		d.Set("encapsulation", string(props.Encapsulation))
+		d.Set("ethertype", props.EtherType)
+		d.Set("guid", props.ResourceGUID)
+		d.Set("mtu", props.Mtu)
+
+		if err := d.Set("link1", flattenExpressRoutePortLink("link1", props.Links)); err != nil {
+			return fmt.Errorf("setting `link1`: %+v", err)
+		}
+		if err := d.Set("link2", flattenExpressRoutePortLink("link2", props.Links)); err != nil {
+			return fmt.Errorf("setting `link2`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceExpressRoutePortDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, expressRoutePortResourceName)
+	defer locks.UnlockByName(id.Name, expressRoutePortResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandExpressRoutePortIdentity(input []interface{}) *network.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	identityIds := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+	for _, id := range v["identity_ids"].([]interface{}) {
+		identityIds[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	}
+
+	return &network.ManagedServiceIdentity{
+		Type:                   network.ResourceIdentityType(v["type"].(string)),
+		UserAssignedIdentities: identityIds,
+	}
+}
+
+func flattenExpressRoutePortIdentity(input *network.ManagedServiceIdentity) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		id, err := msiParse.UserAssignedIdentityID(key)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, id.ID())
+	}
+
+	identityType := string(input.Type)
+	if identityType == "userAssigned" {
+		identityType = string(network.ResourceIdentityTypeUserAssigned)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         identityType,
+			"identity_ids": identityIds,
+		},
+	}, nil
+}
+
+func expandExpressRoutePortLinks(d *schema.ResourceData) *[]network.ExpressRouteLink {
+	links := make([]network.ExpressRouteLink, 0)
+	for _, name := range []string{"link1", "link2"} {
+		input := d.Get(name).([]interface{})
+		if len(input) == 0 || input[0] == nil {
+			continue
+		}
+		v := input[0].(map[string]interface{})
+
+		adminState := network.ExpressRouteLinkAdminStateDisabled
+		if v["admin_enabled"].(bool) {
+			adminState = network.ExpressRouteLinkAdminStateEnabled
+		}
+
+		macSecConfig := network.ExpressRouteLinkMacSecConfig{
+			Cipher: network.ExpressRouteLinkMacSecCipher(v["macsec_cipher"].(string)),
+		}
+		if ckn := v["macsec_ckn_keyvault_secret_id"].(string); ckn != "" {
+			macSecConfig.CknSecretIdentifier = utils.String(ckn)
+		}
+		if cak := v["macsec_cak_keyvault_secret_id"].(string); cak != "" {
+			macSecConfig.CakSecretIdentifier = utils.String(cak)
+		}
+
+		links = append(links, network.ExpressRouteLink{
+			Name: utils.String(name),
+			ExpressRouteLinkPropertiesFormat: &network.ExpressRouteLinkPropertiesFormat{
+				AdminState:   adminState,
+				MacSecConfig: &macSecConfig,
+			},
+		})
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	return &links
+}
+
+func flattenExpressRoutePortLink(name string, input *[]network.ExpressRouteLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	for _, link := range *input {
+		if link.Name == nil || *link.Name != name {
+			continue
+		}
+
+		id := ""
+		if link.ID != nil {
+			id = *link.ID
+		}
+
+		adminEnabled := false
+		connectorType := ""
+		interfaceName := ""
+		patchPanelId := ""
+		rackId := ""
+		routerName := ""
+		macSecCipher := ""
+		macSecCknSecretId := ""
+		macSecCakSecretId := ""
+		if props := link.ExpressRouteLinkPropertiesFormat; props != nil {
+			adminEnabled = props.AdminState == network.ExpressRouteLinkAdminStateEnabled
+			connectorType = string(props.ConnectorType)
+			if props.InterfaceName != nil {
+				interfaceName = *props.InterfaceName
+			}
+			if props.PatchPanelID != nil {
+				patchPanelId = *props.PatchPanelID
+			}
+			if props.RackID != nil {
+				rackId = *props.RackID
+			}
+			if props.RouterName != nil {
+				routerName = *props.RouterName
+			}
+			if config := props.MacSecConfig; config != nil {
+				macSecCipher = string(config.Cipher)
+				if config.CknSecretIdentifier != nil {
+					macSecCknSecretId = *config.CknSecretIdentifier
+				}
+				if config.CakSecretIdentifier != nil {
+					macSecCakSecretId = *config.CakSecretIdentifier
+				}
+			}
+		}
+
+		return []interface{}{
+			map[string]interface{}{
+				"admin_enabled":                 adminEnabled,
+				"macsec_cipher":                 macSecCipher,
+				"macsec_ckn_keyvault_secret_id": macSecCknSecretId,
+				"macsec_cak_keyvault_secret_id": macSecCakSecretId,
+				"id":                            id,
+				"connector_type":                connectorType,
+				"interface_name":                interfaceName,
+				"patch_panel_id":                patchPanelId,
+				"rack_id":                       rackId,
+				"router_name":                   routerName,
+			},
+		}
+	}
+
+	return []interface{}{}
+}
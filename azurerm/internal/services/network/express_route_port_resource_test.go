@@ -0,0 +1,271 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ExpressRoutePortResource struct {
+}
+
+func TestAccExpressRoutePort_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.router_name").Exists(),
+				check.That(data.ResourceName).Key("link2.0.router_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRoutePort_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccExpressRoutePort_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.admin_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("link1.0.macsec_cipher").HasValue("gcm-aes-256"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRoutePort_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRoutePort_circuit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit", "test")
+	r := ExpressRouteCircuitResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: ExpressRoutePortResource{}.circuit(data, 5),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("bandwidth_in_gbps").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: ExpressRoutePortResource{}.circuit(data, 10),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("bandwidth_in_gbps").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ExpressRoutePortResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ExpressRoutePortID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ExpressRoutePortsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ExpressRoutePortResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRoutePortResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "import" {
+  name                = azurerm_express_route_port.test.name
+  resource_group_name = azurerm_express_route_port.test.resource_group_name
+  location            = azurerm_express_route_port.test.location
+  peering_location    = azurerm_express_route_port.test.peering_location
+  bandwidth_in_gbps   = azurerm_express_route_port.test.bandwidth_in_gbps
+  encapsulation       = azurerm_express_route_port.test.encapsulation
+}
+`, r.basic(data))
+}
+
+func (r ExpressRoutePortResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["delete", "get", "list", "purge", "set"]
+  }
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = ["get"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "ckn" {
+  name         = "ckn"
+  value        = "0ae071d6a6ac6c3e0f6a8f8d9ea3f9a8ab9bd8a6ac9b0f5f0e7e2f2de9cd8a8f"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "cak" {
+  name         = "cak"
+  value        = "ab3d4f9ee6a8e9b2ca8e3d0e7f8a6b1c"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  link1 {
+    admin_enabled                 = true
+    macsec_cipher                 = "gcm-aes-256"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+
+  link2 {
+    admin_enabled = false
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ExpressRoutePortResource) circuit(data acceptance.TestData, bandwidthInGbps int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  express_route_port_id = azurerm_express_route_port.test.id
+  bandwidth_in_gbps     = %d
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+`, r.basic(data), data.RandomInteger, bandwidthInGbps)
+}
+
+func (ExpressRoutePortResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-erp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,288 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkDDoSCustomPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkDDoSCustomPolicyCreateUpdate,
+		Read:   resourceNetworkDDoSCustomPolicyRead,
+		Update: resourceNetworkDDoSCustomPolicyCreateUpdate,
+		Delete: resourceNetworkDDoSCustomPolicyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DdosCustomPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"protocol_custom_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosCustomPolicyProtocolTCP),
+								string(network.DdosCustomPolicyProtocolUDP),
+								string(network.DdosCustomPolicyProtocolSyn),
+							}, false),
+						},
+
+						"trigger_rate_override": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"source_rate_override": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"trigger_sensitivity_override": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.Default),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Relaxed),
+								string(network.Low),
+								string(network.Default),
+								string(network.High),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"public_ip_address_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkDDoSCustomPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DDOSCustomPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDdosCustomPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_network_ddos_custom_policy", id.ID())
+		}
+	}
+
+	protocolCustomSettings, err := expandNetworkDDoSCustomPolicyProtocolCustomSettings(d.Get("protocol_custom_setting").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.DdosCustomPolicy{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		DdosCustomPolicyPropertiesFormat: &network.DdosCustomPolicyPropertiesFormat{
+			ProtocolCustomSettings: protocolCustomSettings,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkDDoSCustomPolicyRead(d, meta)
+}
+
+func resourceNetworkDDoSCustomPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DDOSCustomPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DdosCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.DdosCustomPolicyPropertiesFormat; props != nil {
+		protocolCustomSettings, err := flattenNetworkDDoSCustomPolicyProtocolCustomSettings(props.ProtocolCustomSettings)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("protocol_custom_setting", protocolCustomSettings); err != nil {
+			return fmt.Errorf("setting `protocol_custom_setting`: %+v", err)
+		}
+
+		if err := d.Set("public_ip_address_ids", flattenNetworkSubResourceID(props.PublicIPAddresses)); err != nil {
+			return fmt.Errorf("setting `public_ip_address_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetworkDDoSCustomPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DDOSCustomPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DdosCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkDDoSCustomPolicyProtocolCustomSettings(input []interface{}) (*[]network.ProtocolCustomSettingsFormat, error) {
+	results := make([]network.ProtocolCustomSettingsFormat, 0)
+	protocols := make(map[string]struct{})
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		protocol := v["protocol"].(string)
+		if _, exists := protocols[protocol]; exists {
+			return nil, fmt.Errorf("only one `protocol_custom_setting` block can be specified for the protocol %q", protocol)
+		}
+		protocols[protocol] = struct{}{}
+
+		setting := network.ProtocolCustomSettingsFormat{
+			Protocol:                   network.DdosCustomPolicyProtocol(protocol),
+			TriggerSensitivityOverride: network.DdosCustomPolicyTriggerSensitivityOverride(v["trigger_sensitivity_override"].(string)),
+		}
+
+		if rate := v["trigger_rate_override"].(int); rate > 0 {
+			setting.TriggerRateOverride = utils.String(strconv.Itoa(rate))
+		}
+
+		if rate := v["source_rate_override"].(int); rate > 0 {
+			setting.SourceRateOverride = utils.String(strconv.Itoa(rate))
+		}
+
+		results = append(results, setting)
+	}
+
+	return &results, nil
+}
+
+func flattenNetworkDDoSCustomPolicyProtocolCustomSettings(input *[]network.ProtocolCustomSettingsFormat) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		triggerRateOverride := 0
+		if item.TriggerRateOverride != nil && *item.TriggerRateOverride != "" {
+			rate, err := strconv.Atoi(*item.TriggerRateOverride)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `trigger_rate_override` %q for the protocol %q: %+v", *item.TriggerRateOverride, string(item.Protocol), err)
+			}
+			triggerRateOverride = rate
+		}
+
+		sourceRateOverride := 0
+		if item.SourceRateOverride != nil && *item.SourceRateOverride != "" {
+			rate, err := strconv.Atoi(*item.SourceRateOverride)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `source_rate_override` %q for the protocol %q: %+v", *item.SourceRateOverride, string(item.Protocol), err)
+			}
+			sourceRateOverride = rate
+		}
+
+		results = append(results, map[string]interface{}{
+			"protocol":                     string(item.Protocol),
+			"trigger_rate_override":        triggerRateOverride,
+			"source_rate_override":         sourceRateOverride,
+			"trigger_sensitivity_override": string(item.TriggerSensitivityOverride),
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,195 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkDDoSCustomPolicyResource struct {
+}
+
+func TestAccNetworkDDoSCustomPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDDoSCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDDoSCustomPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDDoSCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkDDoSCustomPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDDoSCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protocol_custom_setting.#").HasValue("2"),
+				check.That(data.ResourceName).Key("public_ip_address_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDDoSCustomPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDDoSCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protocol_custom_setting.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t NetworkDDoSCustomPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.DdosCustomPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.DDOSCustomPoliciesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r NetworkDDoSCustomPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "test" {
+  name                = "acctest-ddoscp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  protocol_custom_setting {
+    protocol = "Tcp"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkDDoSCustomPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "import" {
+  name                = azurerm_network_ddos_custom_policy.test.name
+  resource_group_name = azurerm_network_ddos_custom_policy.test.resource_group_name
+  location            = azurerm_network_ddos_custom_policy.test.location
+
+  protocol_custom_setting {
+    protocol = "Tcp"
+  }
+}
+`, r.basic(data))
+}
+
+func (r NetworkDDoSCustomPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "test" {
+  name                = "acctest-ddoscp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  protocol_custom_setting {
+    protocol                     = "Tcp"
+    trigger_rate_override        = 10000
+    source_rate_override         = 5000
+    trigger_sensitivity_override = "High"
+  }
+
+  protocol_custom_setting {
+    protocol                     = "Udp"
+    trigger_sensitivity_override = "Low"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ddos_settings {
+    protection_coverage   = "Standard"
+    ddos_custom_policy_id = azurerm_network_ddos_custom_policy.test.id
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (NetworkDDoSCustomPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ddos-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
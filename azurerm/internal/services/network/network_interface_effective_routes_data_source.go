@@ -0,0 +1,151 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveRoutesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			// computing the effective routes is a long running operation
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"bgp_route_propagation_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.GetEffectiveRouteTable(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Routes for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Effective Routes for %s: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving Effective Routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("route", flattenNetworkInterfaceEffectiveRoutes(resp.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		name := ""
+		if route.Name != nil {
+			name = *route.Name
+		}
+
+		// the API returns this as `disableBgpRoutePropagation`, however it's only ever set when it's `true`
+		bgpRoutePropagationEnabled := true
+		if route.DisableBgpRoutePropagation != nil {
+			bgpRoutePropagationEnabled = !*route.DisableBgpRoutePropagation
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"address_prefixes":              utils.FlattenStringSlice(route.AddressPrefix),
+			"bgp_route_propagation_enabled": bgpRoutePropagationEnabled,
+			"next_hop_ip_addresses":         utils.FlattenStringSlice(route.NextHopIPAddress),
+			"next_hop_type":                 string(route.NextHopType),
+			"source":                        string(route.Source),
+			"state":                         string(route.State),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,175 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkInterfaceEffectiveRoutesDataSource struct {
+}
+
+func TestAccDataSourceNetworkInterfaceEffectiveRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_routes", "test")
+	r := NetworkInterfaceEffectiveRoutesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.#").Exists(),
+				check.That(data.ResourceName).Key("route.0.next_hop_type").Exists(),
+				check.That(data.ResourceName).Key("route.0.source").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkInterfaceEffectiveRoutes_userDefinedRoute(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_routes", "test")
+	r := NetworkInterfaceEffectiveRoutesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.userDefinedRoute(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+
+  depends_on = [azurerm_linux_virtual_machine.test]
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) userDefinedRoute(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  route {
+    name                   = "appliance"
+    address_prefix         = "10.100.0.0/16"
+    next_hop_type          = "VirtualAppliance"
+    next_hop_in_ip_address = "10.0.2.10"
+  }
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = azurerm_subnet.test.id
+  route_table_id = azurerm_route_table.test.id
+}
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+
+  depends_on = [
+    azurerm_linux_virtual_machine.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "deny-ssh"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "Internet"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_security_group_association" "test" {
+  network_interface_id      = azurerm_network_interface.test.id
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids           = [azurerm_network_interface.test.id]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  depends_on = [azurerm_network_interface_security_group_association.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
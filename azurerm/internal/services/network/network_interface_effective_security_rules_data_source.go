@@ -0,0 +1,250 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			// computing the effective security rules is a long running operation
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"network_security_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"associated_network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"associated_subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"destination_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"source_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(resp.Value)); err != nil {
+		return fmt.Errorf("setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		id := ""
+		if group.NetworkSecurityGroup != nil && group.NetworkSecurityGroup.ID != nil {
+			id = *group.NetworkSecurityGroup.ID
+		}
+
+		associatedNetworkInterfaceId := ""
+		associatedSubnetId := ""
+		if association := group.Association; association != nil {
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				associatedNetworkInterfaceId = *association.NetworkInterface.ID
+			}
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				associatedSubnetId = *association.Subnet.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                              id,
+			"associated_network_interface_id": associatedNetworkInterfaceId,
+			"associated_subnet_id":            associatedSubnetId,
+			"rule":                            flattenNetworkInterfaceEffectiveSecurityRules(group.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		priority := 0
+		if rule.Priority != nil {
+			priority = int(*rule.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"access":                                string(rule.Access),
+			"destination_address_prefixes":          effectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+			"destination_port_ranges":               effectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges),
+			"direction":                             string(rule.Direction),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(rule.ExpandedDestinationAddressPrefix),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(rule.ExpandedSourceAddressPrefix),
+			"priority":                              priority,
+			"protocol":                              string(rule.Protocol),
+			"source_address_prefixes":               effectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+			"source_port_ranges":                    effectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges),
+		})
+	}
+
+	return results
+}
+
+// effectiveSecurityRuleValues combines the singular and plural forms of a field, since the API returns
+// one or the other depending on how the rule was defined
+func effectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	values := networkSecurityRuleValues(single, multiple)
+	return utils.FlattenStringSlice(&values)
+}
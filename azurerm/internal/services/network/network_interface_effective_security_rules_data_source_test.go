@@ -0,0 +1,42 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkInterfaceEffectiveSecurityRulesDataSource struct {
+}
+
+func TestAccDataSourceNetworkInterfaceEffectiveSecurityRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_security_rules", "test")
+	r := NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_security_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_security_group.0.id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.associated_network_interface_id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.rule.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_security_rules" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+
+  depends_on = [azurerm_linux_virtual_machine.test]
+}
+`, NetworkInterfaceEffectiveRoutesDataSource{}.template(data))
+}
@@ -0,0 +1,243 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInterfaceVirtualNetworkTapAssociationCreate,
+		Read:   resourceNetworkInterfaceVirtualNetworkTapAssociationRead,
+		Delete: resourceNetworkInterfaceVirtualNetworkTapAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"virtual_network_tap_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualNetworkTapID,
+			},
+
+			"tap_configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Virtual Network Tap Association creation.")
+
+	nicId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tapId, err := parse.VirtualNetworkTapID(d.Get("virtual_network_tap_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
+
+	locks.ByName(tapId.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(tapId.Name, virtualNetworkTapResourceName)
+
+	// first double-check it doesn't exist
+	resourceId := fmt.Sprintf("%s|%s", nicId.ID(), tapId.ID())
+	existing, err := findNetworkInterfaceTapConfiguration(ctx, client, *nicId, *tapId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return tf.ImportAsExistsError("azurerm_network_interface_virtual_network_tap_association", resourceId)
+	}
+
+	// the Tap Configuration is a child of the Network Interface, so we name it after the Virtual Network Tap
+	tapConfigurationName := tapId.Name
+	parameters := network.InterfaceTapConfiguration{
+		Name: utils.String(tapConfigurationName),
+		InterfaceTapConfigurationPropertiesFormat: &network.InterfaceTapConfigurationPropertiesFormat{
+			VirtualNetworkTap: &network.VirtualNetworkTap{
+				ID: utils.String(tapId.ID()),
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, nicId.ResourceGroup, nicId.Name, tapConfigurationName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Tap Configuration %q for Network Interface %q (Resource Group %q): %+v", tapConfigurationName, nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Tap Configuration %q for Network Interface %q (Resource Group %q): %+v", tapConfigurationName, nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceNetworkInterfaceVirtualNetworkTapAssociationRead(d, meta)
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	nicClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nicId, tapId, err := parseNetworkInterfaceVirtualNetworkTapAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nic, err := nicClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nic.Response) {
+			log.Printf("Network Interface %q (Resource Group %q) was not found - removing from state!", nicId.Name, nicId.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	config, err := findNetworkInterfaceTapConfiguration(ctx, client, *nicId, *tapId)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		log.Printf("Network Interface %q (Resource Group %q) isn't associated with Virtual Network Tap %q - removing from state!", nicId.Name, nicId.ResourceGroup, tapId.Name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", nicId.ID())
+	d.Set("virtual_network_tap_id", tapId.ID())
+	d.Set("tap_configuration_name", config.Name)
+
+	return nil
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	nicClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nicId, tapId, err := parseNetworkInterfaceVirtualNetworkTapAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
+
+	locks.ByName(tapId.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(tapId.Name, virtualNetworkTapResourceName)
+
+	nic, err := nicClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+	if err != nil {
+		// the Tap Configuration is removed along with the Network Interface
+		if utils.ResponseWasNotFound(nic.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	config, err := findNetworkInterfaceTapConfiguration(ctx, client, *nicId, *tapId)
+	if err != nil {
+		return err
+	}
+	if config == nil || config.Name == nil {
+		return nil
+	}
+
+	future, err := client.Delete(ctx, nicId.ResourceGroup, nicId.Name, *config.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Tap Configuration %q for Network Interface %q (Resource Group %q): %+v", *config.Name, nicId.Name, nicId.ResourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Tap Configuration %q for Network Interface %q (Resource Group %q): %+v", *config.Name, nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func parseNetworkInterfaceVirtualNetworkTapAssociationID(input string) (*parse.NetworkInterfaceId, *parse.VirtualNetworkTapId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, nil, fmt.Errorf("Expected ID to be in the format {networkInterfaceId}|{virtualNetworkTapId} but got %q", input)
+	}
+
+	nicId, err := parse.NetworkInterfaceID(splitId[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tapId, err := parse.VirtualNetworkTapID(splitId[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nicId, tapId, nil
+}
+
+// findNetworkInterfaceTapConfiguration returns the Tap Configuration on the Network Interface which points to the
+// Virtual Network Tap, since it may have been created outside of Terraform with a different name
+func findNetworkInterfaceTapConfiguration(ctx context.Context, client *network.InterfaceTapConfigurationsClient, nicId parse.NetworkInterfaceId, tapId parse.VirtualNetworkTapId) (*network.InterfaceTapConfiguration, error) {
+	iterator, err := client.ListComplete(ctx, nicId.ResourceGroup, nicId.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Tap Configurations for Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		config := iterator.Value()
+		if props := config.InterfaceTapConfigurationPropertiesFormat; props != nil && props.VirtualNetworkTap != nil && props.VirtualNetworkTap.ID != nil {
+			if strings.EqualFold(*props.VirtualNetworkTap.ID, tapId.ID()) {
+				return &config, nil
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Tap Configurations for Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+		}
+	}
+
+	return nil, nil
+}
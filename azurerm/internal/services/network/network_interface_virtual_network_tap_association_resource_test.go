@@ -0,0 +1,185 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkInterfaceVirtualNetworkTapAssociationResource struct {
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tap_configuration_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_network_interface_virtual_network_tap_association"),
+		},
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_deleted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		// intentionally not using a DisappearsStep since this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.destroy),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_networkInterfaceDeleted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.destroyNetworkInterface),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func (t NetworkInterfaceVirtualNetworkTapAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	splitId := strings.Split(state.ID, "|")
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {networkInterfaceId}|{virtualNetworkTapId} but got %q", state.ID)
+	}
+
+	nicId, err := parse.NetworkInterfaceID(splitId[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.InterfaceTapConfigurationsClient.List(ctx, nicId.ResourceGroup, nicId.Name)
+	if err != nil {
+		return nil, fmt.Errorf("listing Tap Configurations for %s: %+v", *nicId, err)
+	}
+
+	found := false
+	for _, config := range resp.Values() {
+		if props := config.InterfaceTapConfigurationPropertiesFormat; props != nil && props.VirtualNetworkTap != nil && props.VirtualNetworkTap.ID != nil {
+			if strings.EqualFold(*props.VirtualNetworkTap.ID, splitId[1]) {
+				found = true
+			}
+		}
+	}
+
+	return utils.Bool(found), nil
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+	nicId, err := parse.NetworkInterfaceID(state.Attributes["network_interface_id"])
+	if err != nil {
+		return err
+	}
+
+	tapConfigurationName := state.Attributes["tap_configuration_name"]
+	future, err := client.Network.InterfaceTapConfigurationsClient.Delete(ctx, nicId.ResourceGroup, nicId.Name, tapConfigurationName)
+	if err != nil {
+		return fmt.Errorf("removing Tap Configuration %q from %s: %+v", tapConfigurationName, *nicId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Network.InterfaceTapConfigurationsClient.Client); err != nil {
+		return fmt.Errorf("waiting for removal of Tap Configuration %q from %s: %+v", tapConfigurationName, *nicId, err)
+	}
+
+	return nil
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) destroyNetworkInterface(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+	nicId, err := parse.NetworkInterfaceID(state.Attributes["network_interface_id"])
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Network.InterfacesClient.Delete(ctx, nicId.ResourceGroup, nicId.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *nicId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *nicId, err)
+	}
+
+	return nil
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_virtual_network_tap_association" "test" {
+  network_interface_id   = azurerm_network_interface.test.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, VirtualNetworkTapResource{}.networkInterface(data), data.RandomInteger)
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_virtual_network_tap_association" "import" {
+  network_interface_id   = azurerm_network_interface_virtual_network_tap_association.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_virtual_network_tap_association.test.virtual_network_tap_id
+}
+`, r.basic(data))
+}
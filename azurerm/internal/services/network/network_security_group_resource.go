@@ -176,6 +176,8 @@ func resourceNetworkSecurityGroup() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: resourceNetworkSecurityGroupCustomizeDiff,
 	}
 }
 
@@ -203,7 +205,7 @@ func resourceNetworkSecurityGroupCreateUpdate(d *schema.ResourceData, meta inter
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	sgRules, sgErr := expandAzureRmSecurityRules(d)
+	sgRules, sgErr := expandAzureRmSecurityRules(d.Get("security_rule").(*schema.Set).List())
 	if sgErr != nil {
 		return fmt.Errorf("Error Building list of Network Security Group Rules: %+v", sgErr)
 	}
@@ -299,8 +301,22 @@ func resourceNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}
 	return err
 }
 
-func expandAzureRmSecurityRules(d *schema.ResourceData) ([]network.SecurityRule, error) {
-	sgRules := d.Get("security_rule").(*schema.Set).List()
+func resourceNetworkSecurityGroupCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("security_rule") || !d.NewValueKnown("security_rule") {
+		return nil
+	}
+
+	rules, err := expandAzureRmSecurityRules(d.Get("security_rule").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	// Azure rejects duplicate priorities at apply time, so these are caught at plan time (rules which can never match are
+	// only rejected when opted into via the `features` block, otherwise they're logged)
+	return validateNetworkSecurityRulesConflicts(rules, meta.(*clients.Client).Features.Network.RejectShadowedSecurityRules)
+}
+
+func expandAzureRmSecurityRules(sgRules []interface{}) ([]network.SecurityRule, error) {
 	rules := make([]network.SecurityRule, 0)
 
 	for _, sgRaw := range sgRules {
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -212,6 +213,43 @@ func TestAccNetworkSecurityGroup_deleteRule(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSecurityGroup_duplicatePriority(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.duplicatePriority(data),
+			ExpectError: regexp.MustCompile("both use the priority 100 for Inbound traffic"),
+		},
+	})
+}
+
+func TestAccNetworkSecurityGroup_shadowedRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			// rules which are never evaluated are valid, so these are only logged unless opted into
+			Config: r.shadowedRule(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityGroup_shadowedRuleRejected(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.shadowedRule(data, true),
+			ExpectError: regexp.MustCompile(`the security rule "allow-ssh" \(priority 200\) will never be evaluated`),
+		},
+	})
+}
+
 func (t NetworkSecurityGroupResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.NetworkSecurityGroupID(state.ID)
 	if err != nil {
@@ -534,3 +572,93 @@ resource "azurerm_network_security_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (NetworkSecurityGroupResource) duplicatePriority(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "allow-ssh"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-https"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (NetworkSecurityGroupResource) shadowedRule(data acceptance.TestData, rejectShadowedRules bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    network {
+      reject_shadowed_security_rules = %t
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "deny-all"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-ssh"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "10.0.0.0/16"
+    destination_address_prefix = "*"
+  }
+}
+`, rejectShadowedRules, data.RandomInteger, data.Locations.Primary)
+}
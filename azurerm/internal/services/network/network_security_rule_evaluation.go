@@ -0,0 +1,505 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// networkSecurityFlow is a single packet flow (the 5-tuple plus a direction) to be evaluated against a set of Security Rules
+type networkSecurityFlow struct {
+	Direction          network.SecurityRuleDirection
+	Protocol           network.SecurityRuleProtocol
+	SourceAddress      net.IP
+	SourcePort         int
+	DestinationAddress net.IP
+	DestinationPort    int
+}
+
+// networkSecurityServiceTags maps the (case-insensitive) name of a Service Tag to the address prefixes it covers
+type networkSecurityServiceTags map[string][]string
+
+func (t networkSecurityServiceTags) add(name string, prefixes []string) {
+	t[strings.ToLower(name)] = prefixes
+}
+
+func (t networkSecurityServiceTags) lookup(name string) ([]string, bool) {
+	prefixes, ok := t[strings.ToLower(name)]
+	return prefixes, ok
+}
+
+// defaultNetworkSecurityServiceTags returns an approximation of the built-in Service Tags which can't be retrieved
+// from the Service Tags API - since `VirtualNetwork` depends on the Virtual Network (and anything peered/connected
+// to it) it's treated as the private address space unless it's overridden.
+func defaultNetworkSecurityServiceTags() networkSecurityServiceTags {
+	tags := networkSecurityServiceTags{}
+	tags.add("VirtualNetwork", []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"})
+	tags.add("AzureLoadBalancer", []string{"168.63.129.16/32"})
+	return tags
+}
+
+// networkSecurityGroupDefaultRules returns the rules Azure adds to every Network Security Group
+func networkSecurityGroupDefaultRules() []network.SecurityRule {
+	rule := func(name string, priority int32, direction network.SecurityRuleDirection, access network.SecurityRuleAccess, source, destination string) network.SecurityRule {
+		return network.SecurityRule{
+			Name: utils.String(name),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Protocol:                 network.SecurityRuleProtocolAsterisk,
+				SourceAddressPrefix:      utils.String(source),
+				SourcePortRange:          utils.String("*"),
+				DestinationAddressPrefix: utils.String(destination),
+				DestinationPortRange:     utils.String("*"),
+				Access:                   access,
+				Priority:                 utils.Int32(priority),
+				Direction:                direction,
+			},
+		}
+	}
+
+	return []network.SecurityRule{
+		rule("AllowVnetInBound", 65000, network.SecurityRuleDirectionInbound, network.SecurityRuleAccessAllow, "VirtualNetwork", "VirtualNetwork"),
+		rule("AllowAzureLoadBalancerInBound", 65001, network.SecurityRuleDirectionInbound, network.SecurityRuleAccessAllow, "AzureLoadBalancer", "*"),
+		rule("DenyAllInBound", 65500, network.SecurityRuleDirectionInbound, network.SecurityRuleAccessDeny, "*", "*"),
+		rule("AllowVnetOutBound", 65000, network.SecurityRuleDirectionOutbound, network.SecurityRuleAccessAllow, "VirtualNetwork", "VirtualNetwork"),
+		rule("AllowInternetOutBound", 65001, network.SecurityRuleDirectionOutbound, network.SecurityRuleAccessAllow, "*", "Internet"),
+		rule("DenyAllOutBound", 65500, network.SecurityRuleDirectionOutbound, network.SecurityRuleAccessDeny, "*", "*"),
+	}
+}
+
+// validateNetworkSecurityRulesConflicts checks a set of rules belonging to the same Network Security Group for
+// duplicate priorities - rules which are never evaluated since they're shadowed by a higher priority rule are valid
+// (if unintended) so these are only rejected when `rejectShadowedRules` is set, otherwise they're logged
+func validateNetworkSecurityRulesConflicts(rules []network.SecurityRule, rejectShadowedRules bool) error {
+	var err *multierror.Error
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if e := networkSecurityRulesConflict(rules[i], rules[j]); e != nil {
+				err = multierror.Append(err, e)
+			}
+
+			if e := networkSecurityRulesShadowed(rules[i], rules[j], rejectShadowedRules); e != nil {
+				err = multierror.Append(err, e)
+			}
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// validateNetworkSecurityRuleConflicts checks a single rule against the other rules in the same Network Security Group,
+// in the same way as validateNetworkSecurityRulesConflicts
+func validateNetworkSecurityRuleConflicts(rule network.SecurityRule, others []network.SecurityRule, rejectShadowedRules bool) error {
+	var err *multierror.Error
+
+	for _, other := range others {
+		if e := networkSecurityRulesConflict(rule, other); e != nil {
+			err = multierror.Append(err, e)
+		}
+
+		if e := networkSecurityRulesShadowed(rule, other, rejectShadowedRules); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// networkSecurityRulesShadowed returns an error when one rule shadows the other and `rejectShadowedRules` is set,
+// otherwise the shadowing is logged
+func networkSecurityRulesShadowed(first, second network.SecurityRule, rejectShadowedRules bool) error {
+	shadowed := networkSecurityRulesShadowing(first, second)
+	if shadowed == "" {
+		return nil
+	}
+
+	if rejectShadowedRules {
+		return fmt.Errorf("%s", shadowed)
+	}
+
+	log.Printf("[WARN] %s", shadowed)
+	return nil
+}
+
+// networkSecurityRulesConflict returns an error when both rules use the same priority for the same direction,
+// which Azure rejects
+func networkSecurityRulesConflict(first, second network.SecurityRule) error {
+	if first.SecurityRulePropertiesFormat == nil || second.SecurityRulePropertiesFormat == nil {
+		return nil
+	}
+
+	if !strings.EqualFold(string(first.Direction), string(second.Direction)) {
+		return nil
+	}
+
+	firstPriority := networkSecurityRulePriority(first)
+	secondPriority := networkSecurityRulePriority(second)
+
+	// the priority isn't known yet
+	if firstPriority == 0 || secondPriority == 0 {
+		return nil
+	}
+
+	if firstPriority == secondPriority {
+		return fmt.Errorf("the security rules %q and %q both use the priority %d for %s traffic - priorities must be unique per direction", networkSecurityRuleName(first), networkSecurityRuleName(second), firstPriority, first.Direction)
+	}
+
+	return nil
+}
+
+// networkSecurityRulesShadowing returns a description of the shadowing when all traffic matched by the lower
+// priority rule is also matched by the higher priority rule - meaning the lower priority rule is never evaluated
+func networkSecurityRulesShadowing(first, second network.SecurityRule) string {
+	if first.SecurityRulePropertiesFormat == nil || second.SecurityRulePropertiesFormat == nil {
+		return ""
+	}
+
+	if !strings.EqualFold(string(first.Direction), string(second.Direction)) {
+		return ""
+	}
+
+	firstPriority := networkSecurityRulePriority(first)
+	secondPriority := networkSecurityRulePriority(second)
+
+	// the priority isn't known yet, or both rules share a priority (which is a conflict instead)
+	if firstPriority == 0 || secondPriority == 0 || firstPriority == secondPriority {
+		return ""
+	}
+
+	if secondPriority < firstPriority {
+		first, second = second, first
+		firstPriority, secondPriority = secondPriority, firstPriority
+	}
+
+	if !networkSecurityRuleCovers(first, second) {
+		return ""
+	}
+
+	return fmt.Sprintf("the security rule %q (priority %d) will never be evaluated since all traffic it matches is matched by the security rule %q (priority %d)", networkSecurityRuleName(second), secondPriority, networkSecurityRuleName(first), firstPriority)
+}
+
+// networkSecurityRuleCovers returns whether every flow matched by `other` is also matched by `rule`
+func networkSecurityRuleCovers(rule, other network.SecurityRule) bool {
+	if rule.Protocol != network.SecurityRuleProtocolAsterisk && !strings.EqualFold(string(rule.Protocol), string(other.Protocol)) {
+		return false
+	}
+
+	// Application Security Groups can't be resolved offline, so they're treated as a wildcard when they might be
+	// shadowed - and as matching nothing when they might shadow another rule
+	if rule.SourceApplicationSecurityGroups != nil && len(*rule.SourceApplicationSecurityGroups) > 0 {
+		return false
+	}
+	if rule.DestinationApplicationSecurityGroups != nil && len(*rule.DestinationApplicationSecurityGroups) > 0 {
+		return false
+	}
+
+	sourceAddresses := networkSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes)
+	otherSourceAddresses := networkSecurityRuleValues(other.SourceAddressPrefix, other.SourceAddressPrefixes)
+	if other.SourceApplicationSecurityGroups != nil && len(*other.SourceApplicationSecurityGroups) > 0 {
+		otherSourceAddresses = append(otherSourceAddresses, "*")
+	}
+	if !networkSecurityAddressPrefixesCover(sourceAddresses, otherSourceAddresses) {
+		return false
+	}
+
+	destinationAddresses := networkSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes)
+	otherDestinationAddresses := networkSecurityRuleValues(other.DestinationAddressPrefix, other.DestinationAddressPrefixes)
+	if other.DestinationApplicationSecurityGroups != nil && len(*other.DestinationApplicationSecurityGroups) > 0 {
+		otherDestinationAddresses = append(otherDestinationAddresses, "*")
+	}
+	if !networkSecurityAddressPrefixesCover(destinationAddresses, otherDestinationAddresses) {
+		return false
+	}
+
+	sourcePorts := networkSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges)
+	otherSourcePorts := networkSecurityRuleValues(other.SourcePortRange, other.SourcePortRanges)
+	if !networkSecurityPortRangesCover(sourcePorts, otherSourcePorts) {
+		return false
+	}
+
+	destinationPorts := networkSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges)
+	otherDestinationPorts := networkSecurityRuleValues(other.DestinationPortRange, other.DestinationPortRanges)
+	return networkSecurityPortRangesCover(destinationPorts, otherDestinationPorts)
+}
+
+func networkSecurityAddressPrefixesCover(prefixes, others []string) bool {
+	if len(prefixes) == 0 || len(others) == 0 {
+		return false
+	}
+
+	for _, other := range others {
+		covered := false
+		for _, prefix := range prefixes {
+			if networkSecurityAddressPrefixCovers(prefix, other) {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+func networkSecurityAddressPrefixCovers(prefix, other string) bool {
+	if prefix == "*" || strings.EqualFold(prefix, other) {
+		return true
+	}
+
+	prefixNetwork := parseNetworkSecurityAddressPrefix(prefix)
+	otherNetwork := parseNetworkSecurityAddressPrefix(other)
+	if prefixNetwork == nil || otherNetwork == nil {
+		// Service Tags are only compared by name, since what they cover can change over time
+		return false
+	}
+
+	prefixOnes, prefixBits := prefixNetwork.Mask.Size()
+	otherOnes, otherBits := otherNetwork.Mask.Size()
+	return prefixBits == otherBits && prefixOnes <= otherOnes && prefixNetwork.Contains(otherNetwork.IP)
+}
+
+// parseNetworkSecurityAddressPrefix parses an IP Address or CIDR, returning nil for anything else (e.g. a Service Tag)
+func parseNetworkSecurityAddressPrefix(input string) *net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(input); err == nil {
+		return ipNet
+	}
+
+	ip := net.ParseIP(input)
+	if ip == nil {
+		return nil
+	}
+
+	if v4 := ip.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+type networkSecurityPortRange struct {
+	start int
+	end   int
+}
+
+// parseNetworkSecurityPortRange parses a port (`80`), a range of ports (`1024-2048`) or a wildcard (`*`)
+func parseNetworkSecurityPortRange(input string) (*networkSecurityPortRange, error) {
+	if input == "*" {
+		return &networkSecurityPortRange{start: 0, end: 65535}, nil
+	}
+
+	segments := strings.Split(input, "-")
+	if len(segments) > 2 {
+		return nil, fmt.Errorf("expected %q to be a port or a range of ports", input)
+	}
+
+	start, err := strconv.Atoi(strings.TrimSpace(segments[0]))
+	if err != nil {
+		return nil, fmt.Errorf("expected %q to be a port or a range of ports: %+v", input, err)
+	}
+
+	end := start
+	if len(segments) == 2 {
+		if end, err = strconv.Atoi(strings.TrimSpace(segments[1])); err != nil {
+			return nil, fmt.Errorf("expected %q to be a port or a range of ports: %+v", input, err)
+		}
+	}
+
+	if start < 0 || end > 65535 || start > end {
+		return nil, fmt.Errorf("expected %q to be a port or a range of ports between 0 and 65535", input)
+	}
+
+	return &networkSecurityPortRange{start: start, end: end}, nil
+}
+
+func networkSecurityPortRangesCover(ranges, others []string) bool {
+	if len(ranges) == 0 || len(others) == 0 {
+		return false
+	}
+
+	parsed := make([]networkSecurityPortRange, 0)
+	for _, v := range ranges {
+		portRange, err := parseNetworkSecurityPortRange(v)
+		if err != nil {
+			return false
+		}
+		parsed = append(parsed, *portRange)
+	}
+
+	for _, v := range others {
+		other, err := parseNetworkSecurityPortRange(v)
+		if err != nil {
+			return false
+		}
+
+		// walk forwards through the ranges until we've either covered the entire range or hit a gap
+		next := other.start
+		for next <= other.end {
+			advanced := false
+			for _, portRange := range parsed {
+				if portRange.start <= next && portRange.end >= next {
+					next = portRange.end + 1
+					advanced = true
+				}
+			}
+
+			if !advanced {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// evaluateNetworkSecurityRules returns the rule which determines whether the flow is allowed or denied, in the same
+// way Azure does: the matching rule with the lowest priority number wins. nil is returned when no rule matches.
+func evaluateNetworkSecurityRules(rules []network.SecurityRule, flow networkSecurityFlow, serviceTags networkSecurityServiceTags) (*network.SecurityRule, error) {
+	candidates := make([]network.SecurityRule, 0)
+	for _, rule := range rules {
+		if rule.SecurityRulePropertiesFormat == nil || !strings.EqualFold(string(rule.Direction), string(flow.Direction)) {
+			continue
+		}
+		candidates = append(candidates, rule)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return networkSecurityRulePriority(candidates[i]) < networkSecurityRulePriority(candidates[j])
+	})
+
+	for _, rule := range candidates {
+		matches, err := networkSecurityRuleMatches(rule, flow, serviceTags)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating security rule %q: %+v", networkSecurityRuleName(rule), err)
+		}
+
+		if matches {
+			return &rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func networkSecurityRuleMatches(rule network.SecurityRule, flow networkSecurityFlow, serviceTags networkSecurityServiceTags) (bool, error) {
+	if rule.Protocol != network.SecurityRuleProtocolAsterisk && !strings.EqualFold(string(rule.Protocol), string(flow.Protocol)) {
+		return false, nil
+	}
+
+	// Application Security Groups can't be resolved offline, so these rules are skipped
+	if rule.SourceApplicationSecurityGroups != nil && len(*rule.SourceApplicationSecurityGroups) > 0 {
+		return false, nil
+	}
+	if rule.DestinationApplicationSecurityGroups != nil && len(*rule.DestinationApplicationSecurityGroups) > 0 {
+		return false, nil
+	}
+
+	// ICMP has no concept of ports
+	if !strings.EqualFold(string(flow.Protocol), string(network.SecurityRuleProtocolIcmp)) {
+		matches, err := networkSecurityPortMatches(networkSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges), flow.SourcePort)
+		if err != nil || !matches {
+			return false, err
+		}
+
+		matches, err = networkSecurityPortMatches(networkSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges), flow.DestinationPort)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	matches, err := networkSecurityAddressMatches(networkSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes), flow.SourceAddress, serviceTags)
+	if err != nil || !matches {
+		return false, err
+	}
+
+	return networkSecurityAddressMatches(networkSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes), flow.DestinationAddress, serviceTags)
+}
+
+func networkSecurityPortMatches(ranges []string, port int) (bool, error) {
+	for _, v := range ranges {
+		portRange, err := parseNetworkSecurityPortRange(v)
+		if err != nil {
+			return false, err
+		}
+
+		if port >= portRange.start && port <= portRange.end {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func networkSecurityAddressMatches(prefixes []string, address net.IP, serviceTags networkSecurityServiceTags) (bool, error) {
+	for _, prefix := range prefixes {
+		if prefix == "*" {
+			return true, nil
+		}
+
+		if ipNet := parseNetworkSecurityAddressPrefix(prefix); ipNet != nil {
+			if ipNet.Contains(address) {
+				return true, nil
+			}
+			continue
+		}
+
+		tagPrefixes, ok := serviceTags.lookup(prefix)
+		if !ok {
+			// unless it's been defined `Internet` is everything outside of the `VirtualNetwork` Service Tag
+			if strings.EqualFold(prefix, "Internet") {
+				inVirtualNetwork, err := networkSecurityAddressMatches([]string{"VirtualNetwork"}, address, serviceTags)
+				if err != nil {
+					return false, err
+				}
+				if !inVirtualNetwork {
+					return true, nil
+				}
+				continue
+			}
+
+			return false, fmt.Errorf("the Service Tag %q could not be resolved", prefix)
+		}
+
+		for _, tagPrefix := range tagPrefixes {
+			if ipNet := parseNetworkSecurityAddressPrefix(tagPrefix); ipNet != nil && ipNet.Contains(address) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// networkSecurityRuleValues combines the singular and plural forms of a field, since only one of them is set
+func networkSecurityRuleValues(single *string, multiple *[]string) []string {
+	results := make([]string, 0)
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+	if multiple != nil {
+		results = append(results, *multiple...)
+	}
+	return results
+}
+
+func networkSecurityRuleName(rule network.SecurityRule) string {
+	if rule.Name == nil {
+		return ""
+	}
+	return *rule.Name
+}
+
+func networkSecurityRulePriority(rule network.SecurityRule) int {
+	if rule.SecurityRulePropertiesFormat == nil || rule.Priority == nil {
+		return 0
+	}
+	return int(*rule.Priority)
+}
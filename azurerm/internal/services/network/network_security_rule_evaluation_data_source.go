@@ -0,0 +1,330 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkSecurityRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkSecurityRuleEvaluationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+				ExactlyOneOf: []string{"network_security_group_id", "security_rule"},
+			},
+
+			"security_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"network_security_group_id", "security_rule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 4096),
+						},
+
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleDirectionInbound),
+								string(network.SecurityRuleDirectionOutbound),
+							}, false),
+						},
+
+						"access": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleAccessAllow),
+								string(network.SecurityRuleAccessDeny),
+							}, false),
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleProtocolAsterisk),
+								string(network.SecurityRuleProtocolTCP),
+								string(network.SecurityRuleProtocolUDP),
+								string(network.SecurityRuleProtocolIcmp),
+							}, false),
+						},
+
+						"source_address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"source_port_ranges": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"destination_address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"destination_port_ranges": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"include_default_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"service_tag": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+					},
+				},
+			},
+
+			"service_tags_location": azure.SchemaLocationOptional(),
+
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleDirectionInbound),
+					string(network.SecurityRuleDirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleProtocolTCP),
+					string(network.SecurityRuleProtocolUDP),
+					string(network.SecurityRuleProtocolIcmp),
+				}, false),
+			},
+
+			"source_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumberOrZero,
+			},
+
+			"destination_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumberOrZero,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSecurityRuleEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	serviceTagsClient := meta.(*clients.Client).Network.ServiceTagsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	includeDefaultRules := d.Get("include_default_rules").(bool)
+
+	rules := make([]network.SecurityRule, 0)
+	if v, ok := d.GetOk("network_security_group_id"); ok {
+		id, err := parse.NetworkSecurityGroupID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("%s was not found", *id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if props := resp.SecurityGroupPropertiesFormat; props != nil {
+			if props.SecurityRules != nil {
+				rules = append(rules, *props.SecurityRules...)
+			}
+			if includeDefaultRules && props.DefaultSecurityRules != nil {
+				rules = append(rules, *props.DefaultSecurityRules...)
+			}
+		}
+	} else {
+		rules = append(rules, expandNetworkSecurityRuleEvaluationRules(d.Get("security_rule").([]interface{}))...)
+		if includeDefaultRules {
+			rules = append(rules, networkSecurityGroupDefaultRules()...)
+		}
+	}
+
+	serviceTags := defaultNetworkSecurityServiceTags()
+	if v, ok := d.GetOk("service_tags_location"); ok {
+		location := azure.NormalizeLocation(v.(string))
+		resp, err := serviceTagsClient.List(ctx, location)
+		if err != nil {
+			return fmt.Errorf("listing Service Tags for location %q: %+v", location, err)
+		}
+
+		if resp.Values != nil {
+			for _, tag := range *resp.Values {
+				if tag.Name == nil || tag.Properties == nil || tag.Properties.AddressPrefixes == nil {
+					continue
+				}
+				serviceTags.add(*tag.Name, *tag.Properties.AddressPrefixes)
+			}
+		}
+	}
+	for _, raw := range d.Get("service_tag").([]interface{}) {
+		v := raw.(map[string]interface{})
+		serviceTags.add(v["name"].(string), *utils.ExpandStringSlice(v["address_prefixes"].([]interface{})))
+	}
+
+	flow := networkSecurityFlow{
+		Direction:          network.SecurityRuleDirection(d.Get("direction").(string)),
+		Protocol:           network.SecurityRuleProtocol(d.Get("protocol").(string)),
+		SourceAddress:      net.ParseIP(d.Get("source_ip_address").(string)),
+		SourcePort:         d.Get("source_port").(int),
+		DestinationAddress: net.ParseIP(d.Get("destination_ip_address").(string)),
+		DestinationPort:    d.Get("destination_port").(int),
+	}
+
+	matched, err := evaluateNetworkSecurityRules(rules, flow, serviceTags)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	access := ""
+	ruleName := ""
+	rulePriority := 0
+	if matched != nil {
+		access = string(matched.Access)
+		ruleName = networkSecurityRuleName(*matched)
+		rulePriority = networkSecurityRulePriority(*matched)
+	}
+	d.Set("access", access)
+	d.Set("rule_name", ruleName)
+	d.Set("rule_priority", rulePriority)
+
+	return nil
+}
+
+func expandNetworkSecurityRuleEvaluationRules(input []interface{}) []network.SecurityRule {
+	results := make([]network.SecurityRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, network.SecurityRule{
+			Name: utils.String(v["name"].(string)),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Priority:                   utils.Int32(int32(v["priority"].(int))),
+				Direction:                  network.SecurityRuleDirection(v["direction"].(string)),
+				Access:                     network.SecurityRuleAccess(v["access"].(string)),
+				Protocol:                   network.SecurityRuleProtocol(v["protocol"].(string)),
+				SourceAddressPrefixes:      utils.ExpandStringSlice(v["source_address_prefixes"].([]interface{})),
+				SourcePortRanges:           utils.ExpandStringSlice(v["source_port_ranges"].([]interface{})),
+				DestinationAddressPrefixes: utils.ExpandStringSlice(v["destination_address_prefixes"].([]interface{})),
+				DestinationPortRanges:      utils.ExpandStringSlice(v["destination_port_ranges"].([]interface{})),
+			},
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,150 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkSecurityRuleEvaluationDataSource struct {
+}
+
+func TestAccDataSourceNetworkSecurityRuleEvaluation_securityRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_rule_evaluation", "test")
+	r := NetworkSecurityRuleEvaluationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.securityRules(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("deny-ssh"),
+				check.That(data.ResourceName).Key("rule_priority").HasValue("110"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkSecurityRuleEvaluation_networkSecurityGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_rule_evaluation", "test")
+	r := NetworkSecurityRuleEvaluationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.networkSecurityGroup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("allow-storage"),
+				check.That(data.ResourceName).Key("rule_priority").HasValue("100"),
+			),
+		},
+	})
+}
+
+func (NetworkSecurityRuleEvaluationDataSource) securityRules() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_network_security_rule_evaluation" "test" {
+  security_rule {
+    name                         = "allow-https"
+    priority                     = 100
+    direction                    = "Inbound"
+    access                       = "Allow"
+    protocol                     = "Tcp"
+    source_address_prefixes      = ["Internet"]
+    source_port_ranges           = ["*"]
+    destination_address_prefixes = ["10.0.1.0/24"]
+    destination_port_ranges      = ["443"]
+  }
+
+  security_rule {
+    name                         = "deny-ssh"
+    priority                     = 110
+    direction                    = "Inbound"
+    access                       = "Deny"
+    protocol                     = "Tcp"
+    source_address_prefixes      = ["*"]
+    source_port_ranges           = ["*"]
+    destination_address_prefixes = ["*"]
+    destination_port_ranges      = ["22"]
+  }
+
+  direction              = "Inbound"
+  protocol               = "Tcp"
+  source_ip_address      = "13.107.21.200"
+  source_port            = 60000
+  destination_ip_address = "10.0.1.4"
+  destination_port       = 22
+}
+`
+}
+
+func (NetworkSecurityRuleEvaluationDataSource) networkSecurityGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "allow-storage"
+    priority                   = 100
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "Storage"
+  }
+
+  security_rule {
+    name                       = "deny-internet"
+    priority                   = 200
+    direction                  = "Outbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "Internet"
+  }
+}
+
+data "azurerm_network_service_tags" "test" {
+  location = azurerm_resource_group.test.location
+  service  = "Storage"
+}
+
+data "azurerm_network_security_rule_evaluation" "test" {
+  network_security_group_id = azurerm_network_security_group.test.id
+
+  service_tag {
+    name             = "Storage"
+    address_prefixes = data.azurerm_network_service_tags.test.address_prefixes
+  }
+
+  direction              = "Outbound"
+  protocol               = "Tcp"
+  source_ip_address      = "10.0.1.4"
+  source_port            = 60000
+  destination_ip_address = cidrhost(data.azurerm_network_service_tags.test.address_prefixes[0], 1)
+  destination_port       = 443
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
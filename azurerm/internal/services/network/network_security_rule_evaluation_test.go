@@ -0,0 +1,450 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testNetworkSecurityRule(name string, priority int32, direction network.SecurityRuleDirection, access network.SecurityRuleAccess, protocol network.SecurityRuleProtocol, sourceAddress, sourcePort, destinationAddress, destinationPort string) network.SecurityRule {
+	return network.SecurityRule{
+		Name: utils.String(name),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Priority:                 utils.Int32(priority),
+			Direction:                direction,
+			Access:                   access,
+			Protocol:                 protocol,
+			SourceAddressPrefix:      utils.String(sourceAddress),
+			SourcePortRange:          utils.String(sourcePort),
+			DestinationAddressPrefix: utils.String(destinationAddress),
+			DestinationPortRange:     utils.String(destinationPort),
+		},
+	}
+}
+
+func TestValidateNetworkSecurityRulesConflicts(t *testing.T) {
+	inbound := network.SecurityRuleDirectionInbound
+	outbound := network.SecurityRuleDirectionOutbound
+	allow := network.SecurityRuleAccessAllow
+	deny := network.SecurityRuleAccessDeny
+	tcp := network.SecurityRuleProtocolTCP
+	any := network.SecurityRuleProtocolAsterisk
+
+	testData := []struct {
+		name     string
+		rules    []network.SecurityRule
+		shadowed bool
+		expected bool
+	}{
+		{
+			name:     "no rules",
+			rules:    []network.SecurityRule{},
+			expected: true,
+		},
+		{
+			name: "distinct priorities",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, allow, tcp, "*", "*", "*", "22"),
+				testNetworkSecurityRule("second", 110, inbound, allow, tcp, "*", "*", "*", "443"),
+			},
+			expected: true,
+		},
+		{
+			name: "duplicate priority",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, allow, tcp, "*", "*", "*", "22"),
+				testNetworkSecurityRule("second", 100, inbound, allow, tcp, "*", "*", "*", "443"),
+			},
+			expected: false,
+		},
+		{
+			name: "duplicate priority in different directions",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, allow, tcp, "*", "*", "*", "22"),
+				testNetworkSecurityRule("second", 100, outbound, allow, tcp, "*", "*", "*", "22"),
+			},
+			expected: true,
+		},
+		{
+			name: "shadowed by a wildcard",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("deny-all", 100, inbound, deny, any, "*", "*", "*", "*"),
+				testNetworkSecurityRule("allow-ssh", 200, inbound, allow, tcp, "Internet", "*", "10.0.1.0/24", "22"),
+			},
+			shadowed: true,
+			expected: true,
+		},
+		{
+			name: "shadowed regardless of the order defined",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("allow-ssh", 200, inbound, allow, tcp, "10.0.0.0/24", "*", "*", "22"),
+				testNetworkSecurityRule("allow-range", 100, inbound, allow, tcp, "10.0.0.0/16", "*", "*", "20-25"),
+			},
+			shadowed: true,
+			expected: true,
+		},
+		{
+			name: "partially overlapping addresses",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, deny, tcp, "10.0.0.0/24", "*", "*", "22"),
+				testNetworkSecurityRule("second", 200, inbound, allow, tcp, "10.0.0.0/16", "*", "*", "22"),
+			},
+			expected: true,
+		},
+		{
+			name: "partially overlapping ports",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, deny, tcp, "*", "*", "*", "1000-2000"),
+				testNetworkSecurityRule("second", 200, inbound, allow, tcp, "*", "*", "*", "1500-2500"),
+			},
+			expected: true,
+		},
+		{
+			name: "different protocols",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, deny, tcp, "*", "*", "*", "*"),
+				testNetworkSecurityRule("second", 200, inbound, allow, network.SecurityRuleProtocolUDP, "*", "*", "*", "*"),
+			},
+			expected: true,
+		},
+		{
+			name: "service tags are only compared by name",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 100, inbound, deny, tcp, "VirtualNetwork", "*", "*", "*"),
+				testNetworkSecurityRule("second", 200, inbound, allow, tcp, "10.0.0.0/16", "*", "*", "*"),
+			},
+			expected: true,
+		},
+		{
+			name: "unknown priority",
+			rules: []network.SecurityRule{
+				testNetworkSecurityRule("first", 0, inbound, deny, any, "*", "*", "*", "*"),
+				testNetworkSecurityRule("second", 0, inbound, allow, tcp, "*", "*", "*", "22"),
+			},
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		for _, rejectShadowedRules := range []bool{false, true} {
+			expected := v.expected && !(rejectShadowedRules && v.shadowed)
+
+			err := validateNetworkSecurityRulesConflicts(v.rules, rejectShadowedRules)
+			if actual := err == nil; actual != expected {
+				t.Fatalf("Expected %t but got %t for %q (rejecting shadowed rules: %t): %+v", expected, actual, v.name, rejectShadowedRules, err)
+			}
+		}
+	}
+}
+
+func TestNetworkSecurityRulesShadowing(t *testing.T) {
+	inbound := network.SecurityRuleDirectionInbound
+	outbound := network.SecurityRuleDirectionOutbound
+	allow := network.SecurityRuleAccessAllow
+	deny := network.SecurityRuleAccessDeny
+	tcp := network.SecurityRuleProtocolTCP
+	any := network.SecurityRuleProtocolAsterisk
+
+	testData := []struct {
+		name     string
+		first    network.SecurityRule
+		second   network.SecurityRule
+		expected bool
+	}{
+		{
+			name:     "shadowed by a wildcard",
+			first:    testNetworkSecurityRule("deny-all", 100, inbound, deny, any, "*", "*", "*", "*"),
+			second:   testNetworkSecurityRule("allow-ssh", 200, inbound, allow, tcp, "Internet", "*", "10.0.1.0/24", "22"),
+			expected: true,
+		},
+		{
+			name:     "shadowed regardless of the order defined",
+			first:    testNetworkSecurityRule("allow-ssh", 200, inbound, allow, tcp, "10.0.0.0/24", "*", "*", "22"),
+			second:   testNetworkSecurityRule("allow-range", 100, inbound, allow, tcp, "10.0.0.0/16", "*", "*", "20-25"),
+			expected: true,
+		},
+		{
+			name:     "different directions",
+			first:    testNetworkSecurityRule("deny-all", 100, inbound, deny, any, "*", "*", "*", "*"),
+			second:   testNetworkSecurityRule("allow-ssh", 200, outbound, allow, tcp, "*", "*", "*", "22"),
+			expected: false,
+		},
+		{
+			name:     "partially overlapping ports",
+			first:    testNetworkSecurityRule("first", 100, inbound, deny, tcp, "*", "*", "*", "1000-2000"),
+			second:   testNetworkSecurityRule("second", 200, inbound, allow, tcp, "*", "*", "*", "1500-2500"),
+			expected: false,
+		},
+		{
+			name:     "duplicate priority",
+			first:    testNetworkSecurityRule("first", 100, inbound, deny, any, "*", "*", "*", "*"),
+			second:   testNetworkSecurityRule("second", 100, inbound, allow, tcp, "*", "*", "*", "22"),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := networkSecurityRulesShadowing(v.first, v.second) != ""; actual != v.expected {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestValidateNetworkSecurityRuleConflicts(t *testing.T) {
+	inbound := network.SecurityRuleDirectionInbound
+	allow := network.SecurityRuleAccessAllow
+	deny := network.SecurityRuleAccessDeny
+	tcp := network.SecurityRuleProtocolTCP
+	any := network.SecurityRuleProtocolAsterisk
+
+	rule := testNetworkSecurityRule("allow-ssh", 200, inbound, allow, tcp, "*", "*", "*", "22")
+	others := []network.SecurityRule{
+		testNetworkSecurityRule("deny-all", 100, inbound, deny, any, "*", "*", "*", "*"),
+		testNetworkSecurityRule("allow-https", 200, inbound, allow, tcp, "*", "*", "*", "443"),
+		testNetworkSecurityRule("allow-http", 300, inbound, allow, tcp, "*", "*", "*", "80"),
+	}
+
+	err := validateNetworkSecurityRuleConflicts(rule, others, false)
+	if err == nil || len(err.(*multierror.Error).Errors) != 1 {
+		t.Fatalf("Expected the duplicate priority to be rejected but got: %+v", err)
+	}
+
+	err = validateNetworkSecurityRuleConflicts(rule, others, true)
+	if err == nil || len(err.(*multierror.Error).Errors) != 2 {
+		t.Fatalf("Expected the duplicate priority and shadowed rule to be rejected but got: %+v", err)
+	}
+}
+
+func TestParseNetworkSecurityPortRange(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected *networkSecurityPortRange
+	}{
+		{
+			input:    "*",
+			expected: &networkSecurityPortRange{start: 0, end: 65535},
+		},
+		{
+			input:    "80",
+			expected: &networkSecurityPortRange{start: 80, end: 80},
+		},
+		{
+			input:    "1024-2048",
+			expected: &networkSecurityPortRange{start: 1024, end: 2048},
+		},
+		{
+			input:    "2048-1024",
+			expected: nil,
+		},
+		{
+			input:    "65536",
+			expected: nil,
+		},
+		{
+			input:    "http",
+			expected: nil,
+		},
+		{
+			input:    "1-2-3",
+			expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := parseNetworkSecurityPortRange(v.input)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", v.input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.input, err)
+		}
+		if *actual != *v.expected {
+			t.Fatalf("Expected %+v but got %+v for %q", *v.expected, *actual, v.input)
+		}
+	}
+}
+
+func TestEvaluateNetworkSecurityRules(t *testing.T) {
+	inbound := network.SecurityRuleDirectionInbound
+	outbound := network.SecurityRuleDirectionOutbound
+	allow := network.SecurityRuleAccessAllow
+	deny := network.SecurityRuleAccessDeny
+	tcp := network.SecurityRuleProtocolTCP
+
+	rules := []network.SecurityRule{
+		testNetworkSecurityRule("allow-https", 100, inbound, allow, tcp, "Internet", "*", "10.0.1.0/24", "443"),
+		testNetworkSecurityRule("deny-ssh", 110, inbound, deny, tcp, "*", "*", "*", "22"),
+		testNetworkSecurityRule("allow-storage", 100, outbound, allow, tcp, "*", "*", "Storage", "443"),
+		testNetworkSecurityRule("deny-internet", 200, outbound, deny, network.SecurityRuleProtocolAsterisk, "*", "*", "Internet", "*"),
+	}
+	rules = append(rules, networkSecurityGroupDefaultRules()...)
+
+	serviceTags := defaultNetworkSecurityServiceTags()
+	serviceTags.add("Storage", []string{"52.239.0.0/16"})
+
+	testData := []struct {
+		name     string
+		flow     networkSecurityFlow
+		rule     string
+		access   network.SecurityRuleAccess
+		hasError bool
+	}{
+		{
+			name: "https from the internet",
+			flow: networkSecurityFlow{
+				Direction:          inbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("13.107.21.200"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    443,
+			},
+			rule:   "allow-https",
+			access: allow,
+		},
+		{
+			name: "ssh from the virtual network",
+			flow: networkSecurityFlow{
+				Direction:          inbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("10.0.2.4"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    22,
+			},
+			rule:   "deny-ssh",
+			access: deny,
+		},
+		{
+			name: "https from the virtual network falls through to the default rules",
+			flow: networkSecurityFlow{
+				Direction:          inbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("10.0.2.4"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    443,
+			},
+			rule:   "AllowVnetInBound",
+			access: allow,
+		},
+		{
+			name: "http from the internet is denied by default",
+			flow: networkSecurityFlow{
+				Direction:          inbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("13.107.21.200"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    80,
+			},
+			rule:   "DenyAllInBound",
+			access: deny,
+		},
+		{
+			name: "icmp from the load balancer",
+			flow: networkSecurityFlow{
+				Direction:          inbound,
+				Protocol:           network.SecurityRuleProtocolIcmp,
+				SourceAddress:      net.ParseIP("168.63.129.16"),
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+			},
+			rule:   "AllowAzureLoadBalancerInBound",
+			access: allow,
+		},
+		{
+			name: "https to a service tag",
+			flow: networkSecurityFlow{
+				Direction:          outbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("10.0.1.4"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("52.239.1.1"),
+				DestinationPort:    443,
+			},
+			rule:   "allow-storage",
+			access: allow,
+		},
+		{
+			name: "https to the internet",
+			flow: networkSecurityFlow{
+				Direction:          outbound,
+				Protocol:           tcp,
+				SourceAddress:      net.ParseIP("10.0.1.4"),
+				SourcePort:         60000,
+				DestinationAddress: net.ParseIP("13.107.21.200"),
+				DestinationPort:    443,
+			},
+			rule:   "deny-internet",
+			access: deny,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := evaluateNetworkSecurityRules(rules, v.flow, serviceTags)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.name, err)
+		}
+		if actual == nil {
+			t.Fatalf("Expected %q to match a rule but it didn't", v.name)
+		}
+		if *actual.Name != v.rule || actual.Access != v.access {
+			t.Fatalf("Expected %q to match %q (%s) but got %q (%s)", v.name, v.rule, v.access, *actual.Name, actual.Access)
+		}
+	}
+}
+
+func TestEvaluateNetworkSecurityRulesUnresolvedServiceTag(t *testing.T) {
+	rules := []network.SecurityRule{
+		testNetworkSecurityRule("allow-sql", 100, network.SecurityRuleDirectionOutbound, network.SecurityRuleAccessAllow, network.SecurityRuleProtocolTCP, "*", "*", "Sql", "1433"),
+	}
+	flow := networkSecurityFlow{
+		Direction:          network.SecurityRuleDirectionOutbound,
+		Protocol:           network.SecurityRuleProtocolTCP,
+		SourceAddress:      net.ParseIP("10.0.1.4"),
+		SourcePort:         60000,
+		DestinationAddress: net.ParseIP("40.78.225.32"),
+		DestinationPort:    1433,
+	}
+
+	if _, err := evaluateNetworkSecurityRules(rules, flow, defaultNetworkSecurityServiceTags()); err == nil {
+		t.Fatalf("Expected an error for an unresolved Service Tag but didn't get one")
+	}
+}
+
+func TestEvaluateNetworkSecurityRulesNoMatch(t *testing.T) {
+	rules := []network.SecurityRule{
+		testNetworkSecurityRule("allow-ssh", 100, network.SecurityRuleDirectionInbound, network.SecurityRuleAccessAllow, network.SecurityRuleProtocolTCP, "*", "*", "*", "22"),
+	}
+	flow := networkSecurityFlow{
+		Direction:          network.SecurityRuleDirectionInbound,
+		Protocol:           network.SecurityRuleProtocolUDP,
+		SourceAddress:      net.ParseIP("10.0.2.4"),
+		SourcePort:         60000,
+		DestinationAddress: net.ParseIP("10.0.1.4"),
+		DestinationPort:    22,
+	}
+
+	actual, err := evaluateNetworkSecurityRules(rules, flow, defaultNetworkSecurityServiceTags())
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("Expected no rule to match but got %q", *actual.Name)
+	}
+}
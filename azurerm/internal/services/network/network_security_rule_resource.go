@@ -1,7 +1,9 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
@@ -166,9 +168,101 @@ func resourceNetworkSecurityRule() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
+
+		CustomizeDiff: resourceNetworkSecurityRuleCustomizeDiff,
 	}
 }
 
+// resourceNetworkSecurityRuleCustomizeDiff checks this rule against the other rules in the Network Security Group (both
+// inline and standalone) - since Azure only rejects a duplicate priority once the rule is being applied. Since Azure
+// checks each rule as it's applied, swapping the priorities of two rules in a single apply fails regardless.
+func resourceNetworkSecurityRuleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	fields := []string{
+		"priority",
+		"direction",
+		"protocol",
+		"source_port_range",
+		"source_port_ranges",
+		"destination_port_range",
+		"destination_port_ranges",
+		"source_address_prefix",
+		"source_address_prefixes",
+		"destination_address_prefix",
+		"destination_address_prefixes",
+		"source_application_security_group_ids",
+		"destination_application_security_group_ids",
+	}
+	hasChanges := d.Id() == ""
+	for _, field := range fields {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+		if d.HasChange(field) {
+			hasChanges = true
+		}
+	}
+	if !hasChanges || !d.NewValueKnown("resource_group_name") || !d.NewValueKnown("network_security_group_name") {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	nsgName := d.Get("network_security_group_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	nsg, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		// the Network Security Group is being created alongside this rule
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): %+v", nsgName, resGroup, err)
+	}
+
+	others := make([]network.SecurityRule, 0)
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, other := range *props.SecurityRules {
+			if other.Name != nil && strings.EqualFold(*other.Name, name) {
+				continue
+			}
+			// when this rule is renamed the existing rule is replaced, so it's not a conflict
+			if other.ID != nil && d.Id() != "" && strings.EqualFold(*other.ID, d.Id()) {
+				continue
+			}
+			others = append(others, other)
+		}
+	}
+
+	rule := network.SecurityRule{
+		Name: utils.String(name),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			SourcePortRange:                      utils.String(d.Get("source_port_range").(string)),
+			SourcePortRanges:                     utils.ExpandStringSlice(d.Get("source_port_ranges").(*schema.Set).List()),
+			DestinationPortRange:                 utils.String(d.Get("destination_port_range").(string)),
+			DestinationPortRanges:                utils.ExpandStringSlice(d.Get("destination_port_ranges").(*schema.Set).List()),
+			SourceAddressPrefix:                  utils.String(d.Get("source_address_prefix").(string)),
+			SourceAddressPrefixes:                utils.ExpandStringSlice(d.Get("source_address_prefixes").(*schema.Set).List()),
+			DestinationAddressPrefix:             utils.String(d.Get("destination_address_prefix").(string)),
+			DestinationAddressPrefixes:           utils.ExpandStringSlice(d.Get("destination_address_prefixes").(*schema.Set).List()),
+			SourceApplicationSecurityGroups:      expandNetworkSecurityRuleApplicationSecurityGroups(d.Get("source_application_security_group_ids").(*schema.Set).List()),
+			DestinationApplicationSecurityGroups: expandNetworkSecurityRuleApplicationSecurityGroups(d.Get("destination_application_security_group_ids").(*schema.Set).List()),
+			Priority:                             utils.Int32(int32(d.Get("priority").(int))),
+			Access:                               network.SecurityRuleAccess(d.Get("access").(string)),
+			Direction:                            network.SecurityRuleDirection(d.Get("direction").(string)),
+			Protocol:                             network.SecurityRuleProtocol(d.Get("protocol").(string)),
+		},
+	}
+
+	if err := validateNetworkSecurityRuleConflicts(rule, others, meta.(*clients.Client).Features.Network.RejectShadowedSecurityRules); err != nil {
+		return fmt.Errorf("validating Security Rule %q against the existing rules in Network Security Group %q (Resource Group %q): %+v", name, nsgName, resGroup, err)
+	}
+
+	return nil
+}
+
 func resourceNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SecurityRuleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -391,6 +485,16 @@ func resourceNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func expandNetworkSecurityRuleApplicationSecurityGroups(input []interface{}) *[]network.ApplicationSecurityGroup {
+	groups := make([]network.ApplicationSecurityGroup, 0)
+	for _, v := range input {
+		groups = append(groups, network.ApplicationSecurityGroup{
+			ID: utils.String(v.(string)),
+		})
+	}
+	return &groups
+}
+
 func flattenApplicationSecurityGroupIds(groups *[]network.ApplicationSecurityGroup) []string {
 	ids := make([]string, 0)
 
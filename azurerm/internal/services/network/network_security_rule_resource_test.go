@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/response"
@@ -111,6 +112,43 @@ func TestAccNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSecurityRule_conflictsWithInlineRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rule", "test")
+	r := NetworkSecurityRuleResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.inlineRule(data),
+		},
+		{
+			Config:      r.conflictsWithInlineRule(data),
+			ExpectError: regexp.MustCompile(`both use the priority 100 for Inbound traffic`),
+		},
+	})
+}
+
+func TestAccNetworkSecurityRule_rename(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rule", "test")
+	r := NetworkSecurityRuleResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the existing rule is replaced, so sharing its priority isn't a conflict
+			Config: r.renamed(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("test456"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t NetworkSecurityRuleResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -184,6 +222,39 @@ resource "azurerm_network_security_rule" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (NetworkSecurityRuleResource) renamed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "test456"
+  network_security_group_name = azurerm_network_security_group.test.name
+  resource_group_name         = azurerm_resource_group.test.name
+  priority                    = 100
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r NetworkSecurityRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -373,3 +444,54 @@ resource "azurerm_network_security_rule" "test1" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (NetworkSecurityRuleResource) inlineRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "inline"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkSecurityRuleResource) conflictsWithInlineRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "test123"
+  network_security_group_name = azurerm_network_security_group.test.name
+  resource_group_name         = azurerm_resource_group.test.name
+  priority                    = 100
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "443"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+`, r.inlineRule(data))
+}
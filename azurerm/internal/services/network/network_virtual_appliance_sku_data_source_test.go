@@ -0,0 +1,40 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkVirtualApplianceSkuDataSource struct {
+}
+
+func TestAccDataSourceNetworkVirtualApplianceSku_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_virtual_appliance_sku", "test")
+	r := NetworkVirtualApplianceSkuDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("vendor").Exists(),
+				check.That(data.ResourceName).Key("available_versions.#").Exists(),
+				check.That(data.ResourceName).Key("available_scale_unit.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkVirtualApplianceSkuDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_network_virtual_appliance_sku" "test" {
+  name = "barracudasdwanrelease"
+}
+`
+}
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkWatcherIPFlowVerify() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkWatcherIPFlowVerifyRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			// verifying the flow is a long running operation
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"target_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Inbound),
+					string(network.Outbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPFlowProtocolTCP),
+					string(network.IPFlowProtocolUDP),
+				}, false),
+			},
+
+			"local_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"remote_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"remote_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherIPFlowVerifyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VerificationIPFlowParameters{
+		TargetResourceID: utils.String(d.Get("target_resource_id").(string)),
+		Direction:        network.Direction(d.Get("direction").(string)),
+		Protocol:         network.IPFlowProtocol(d.Get("protocol").(string)),
+		LocalIPAddress:   utils.String(d.Get("local_ip_address").(string)),
+		LocalPort:        utils.String(strconv.Itoa(d.Get("local_port").(int))),
+		RemoteIPAddress:  utils.String(d.Get("remote_ip_address").(string)),
+		RemotePort:       utils.String(strconv.Itoa(d.Get("remote_port").(int))),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.VerifyIPFlow(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("verifying IP Flow using %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for IP Flow verification using %s: %+v", *watcherId, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving IP Flow verification result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("network_watcher_id", watcherId.ID())
+	d.Set("access", string(resp.Access))
+	d.Set("rule_name", resp.RuleName)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerifyDataSource struct {
+}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_denied(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.config(data, "Inbound", 22),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_allowed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.config(data, "Outbound", 443),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) config(data acceptance.TestData, direction string, localPort int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "deny-ssh"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_interface_security_group_association" "test" {
+  network_interface_id      = azurerm_network_interface.test.id
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.test.id
+  direction          = "%s"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.test.private_ip_address
+  local_port         = %d
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = 60000
+
+  depends_on = [
+    azurerm_virtual_machine_extension.test,
+    azurerm_network_interface_security_group_association.test,
+  ]
+}
+`, NetworkPacketCaptureResource{}.base(data), data.RandomInteger, direction, localPort)
+}
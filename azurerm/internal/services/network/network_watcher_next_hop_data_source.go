@@ -0,0 +1,125 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkWatcherNextHop() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkWatcherNextHopRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			// determining the next hop is a long running operation
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"target_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"source_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"next_hop_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"next_hop_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherNextHopRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.NextHopParameters{
+		TargetResourceID:     utils.String(d.Get("target_resource_id").(string)),
+		SourceIPAddress:      utils.String(d.Get("source_ip_address").(string)),
+		DestinationIPAddress: utils.String(d.Get("destination_ip_address").(string)),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.GetNextHop(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("retrieving Next Hop using %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Next Hop using %s: %+v", *watcherId, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Next Hop result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("network_watcher_id", watcherId.ID())
+	d.Set("next_hop_ip_address", resp.NextHopIPAddress)
+	d.Set("next_hop_type", string(resp.NextHopType))
+
+	// this is returned as `System Route` when the next hop isn't from a User Defined Route
+	routeTableId := ""
+	if resp.RouteTableID != nil {
+		if _, err := parse.RouteTableID(*resp.RouteTableID); err == nil {
+			routeTableId = *resp.RouteTableID
+		}
+	}
+	d.Set("route_table_id", routeTableId)
+
+	return nil
+}
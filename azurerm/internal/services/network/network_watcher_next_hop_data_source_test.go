@@ -0,0 +1,95 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherNextHopDataSource struct {
+}
+
+func testAccDataSourceNetworkWatcherNextHop_internet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.internet(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("Internet"),
+				check.That(data.ResourceName).Key("route_table_id").HasValue(""),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherNextHop_virtualAppliance(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.virtualAppliance(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("VirtualAppliance"),
+				check.That(data.ResourceName).Key("next_hop_ip_address").HasValue("10.0.2.250"),
+				check.That(data.ResourceName).Key("route_table_id").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherNextHopDataSource) internet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id     = azurerm_network_watcher.test.id
+  target_resource_id     = azurerm_virtual_machine.test.id
+  source_ip_address      = azurerm_network_interface.test.private_ip_address
+  destination_ip_address = "13.107.21.200"
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
+
+func (NetworkWatcherNextHopDataSource) virtualAppliance(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  route {
+    name                   = "appliance"
+    address_prefix         = "10.100.0.0/16"
+    next_hop_type          = "VirtualAppliance"
+    next_hop_in_ip_address = "10.0.2.250"
+  }
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = azurerm_subnet.test.id
+  route_table_id = azurerm_route_table.test.id
+}
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id     = azurerm_network_watcher.test.id
+  target_resource_id     = azurerm_virtual_machine.test.id
+  source_ip_address      = azurerm_network_interface.test.private_ip_address
+  destination_ip_address = "10.100.1.1"
+
+  depends_on = [
+    azurerm_virtual_machine_extension.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+}
+`, NetworkPacketCaptureResource{}.base(data), data.RandomInteger)
+}
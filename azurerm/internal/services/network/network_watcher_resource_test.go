@@ -32,6 +32,14 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceNetworkWatcher_basic,
 		},
+		"IPFlowVerifyDataSource": {
+			"allowed": testAccDataSourceNetworkWatcherIPFlowVerify_allowed,
+			"denied":  testAccDataSourceNetworkWatcherIPFlowVerify_denied,
+		},
+		"NextHopDataSource": {
+			"internet":         testAccDataSourceNetworkWatcherNextHop_internet,
+			"virtualAppliance": testAccDataSourceNetworkWatcherNextHop_virtualAppliance,
+		},
 		"PacketCaptureOld": {
 			"localDisk":                  testAccPacketCapture_localDisk,
 			"storageAccount":             testAccPacketCapture_storageAccount,
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApplicationGatewayPrivateEndpointConnectionId struct {
+	SubscriptionId                string
+	ResourceGroup                 string
+	ApplicationGatewayName        string
+	PrivateEndpointConnectionName string
+}
+
+func NewApplicationGatewayPrivateEndpointConnectionID(subscriptionId, resourceGroup, applicationGatewayName, privateEndpointConnectionName string) ApplicationGatewayPrivateEndpointConnectionId {
+	return ApplicationGatewayPrivateEndpointConnectionId{
+		SubscriptionId:                subscriptionId,
+		ResourceGroup:                 resourceGroup,
+		ApplicationGatewayName:        applicationGatewayName,
+		PrivateEndpointConnectionName: privateEndpointConnectionName,
+	}
+}
+
+func (id ApplicationGatewayPrivateEndpointConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Private Endpoint Connection Name %q", id.PrivateEndpointConnectionName),
+		fmt.Sprintf("Application Gateway Name %q", id.ApplicationGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Gateway Private Endpoint Connection", segmentsStr)
+}
+
+func (id ApplicationGatewayPrivateEndpointConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/privateEndpointConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateEndpointConnectionName)
+}
+
+// ApplicationGatewayPrivateEndpointConnectionID parses a ApplicationGatewayPrivateEndpointConnection ID into an ApplicationGatewayPrivateEndpointConnectionId struct
+func ApplicationGatewayPrivateEndpointConnectionID(input string) (*ApplicationGatewayPrivateEndpointConnectionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationGatewayPrivateEndpointConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrivateEndpointConnectionName, err = id.PopSegment("privateEndpointConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
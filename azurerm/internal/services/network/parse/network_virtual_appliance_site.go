@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type NetworkVirtualApplianceSiteId struct {
+	SubscriptionId              string
+	ResourceGroup               string
+	NetworkVirtualApplianceName string
+	VirtualApplianceSiteName    string
+}
+
+func NewNetworkVirtualApplianceSiteID(subscriptionId, resourceGroup, networkVirtualApplianceName, virtualApplianceSiteName string) NetworkVirtualApplianceSiteId {
+	return NetworkVirtualApplianceSiteId{
+		SubscriptionId:              subscriptionId,
+		ResourceGroup:               resourceGroup,
+		NetworkVirtualApplianceName: networkVirtualApplianceName,
+		VirtualApplianceSiteName:    virtualApplianceSiteName,
+	}
+}
+
+func (id NetworkVirtualApplianceSiteId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Appliance Site Name %q", id.VirtualApplianceSiteName),
+		fmt.Sprintf("Network Virtual Appliance Name %q", id.NetworkVirtualApplianceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Virtual Appliance Site", segmentsStr)
+}
+
+func (id NetworkVirtualApplianceSiteId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkVirtualAppliances/%s/virtualApplianceSites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+}
+
+// NetworkVirtualApplianceSiteID parses a NetworkVirtualApplianceSite ID into an NetworkVirtualApplianceSiteId struct
+func NetworkVirtualApplianceSiteID(input string) (*NetworkVirtualApplianceSiteId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkVirtualApplianceSiteId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkVirtualApplianceName, err = id.PopSegment("networkVirtualAppliances"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualApplianceSiteName, err = id.PopSegment("virtualApplianceSites"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type VirtualRouterPeeringId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	VirtualRouterName string
+	PeeringName       string
+}
+
+func NewVirtualRouterPeeringID(subscriptionId, resourceGroup, virtualRouterName, peeringName string) VirtualRouterPeeringId {
+	return VirtualRouterPeeringId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		VirtualRouterName: virtualRouterName,
+		PeeringName:       peeringName,
+	}
+}
+
+func (id VirtualRouterPeeringId) String() string {
+	segments := []string{
+		fmt.Sprintf("Peering Name %q", id.PeeringName),
+		fmt.Sprintf("Virtual Router Name %q", id.VirtualRouterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Router Peering", segmentsStr)
+}
+
+func (id VirtualRouterPeeringId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualRouters/%s/peerings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualRouterName, id.PeeringName)
+}
+
+// VirtualRouterPeeringID parses a VirtualRouterPeering ID into an VirtualRouterPeeringId struct
+func VirtualRouterPeeringID(input string) (*VirtualRouterPeeringId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualRouterPeeringId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualRouterName, err = id.PopSegment("virtualRouters"); err != nil {
+		return nil, err
+	}
+	if resourceId.PeeringName, err = id.PopSegment("peerings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -87,6 +89,11 @@ func dataSourcePrivateLinkServiceEndpointConnectionsRead(d *schema.ResourceData,
 		return fmt.Errorf("Error parsing %q: %s", serviceId, err)
 	}
 
+	// Application Gateways with a Private Link Configuration can also be the target of a Private Endpoint
+	if _, ok := id.Path["applicationGateways"]; ok {
+		return dataSourcePrivateLinkServiceEndpointConnectionsReadApplicationGateway(d, meta, serviceId)
+	}
+
 	name := id.Path["privateLinkServices"]
 	resourceGroup := d.Get("resource_group_name").(string)
 
@@ -117,6 +124,57 @@ func dataSourcePrivateLinkServiceEndpointConnectionsRead(d *schema.ResourceData,
 	return nil
 }
 
+func dataSourcePrivateLinkServiceEndpointConnectionsReadApplicationGateway(d *schema.ResourceData, meta interface{}, serviceId string) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayID(serviceId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("service_id", serviceId)
+	d.Set("service_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		connections := make([]network.PrivateEndpointConnection, 0)
+		if props.PrivateEndpointConnections != nil {
+			for _, item := range *props.PrivateEndpointConnections {
+				connection := network.PrivateEndpointConnection{
+					ID:   item.ID,
+					Name: item.Name,
+				}
+				if itemProps := item.ApplicationGatewayPrivateEndpointConnectionProperties; itemProps != nil {
+					connection.PrivateEndpointConnectionProperties = &network.PrivateEndpointConnectionProperties{
+						PrivateEndpoint:                   itemProps.PrivateEndpoint,
+						PrivateLinkServiceConnectionState: itemProps.PrivateLinkServiceConnectionState,
+					}
+				}
+				connections = append(connections, connection)
+			}
+		}
+
+		if err := d.Set("private_endpoint_connections", dataSourceflattenPrivateLinkServicePrivateEndpointConnections(&connections)); err != nil {
+			return fmt.Errorf("setting `private_endpoint_connections`: %+v", err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/privateLinkServiceEndpointConnections/%s", id.ID(), id.Name))
+
+	return nil
+}
+
 func dataSourceflattenPrivateLinkServicePrivateEndpointConnections(input *[]network.PrivateEndpointConnection) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
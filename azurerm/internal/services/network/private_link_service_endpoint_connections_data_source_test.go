@@ -32,6 +32,24 @@ func TestAccDataSourcePrivateLinkServiceEndpointConnections_complete(t *testing.
 	})
 }
 
+func TestAccDataSourcePrivateLinkServiceEndpointConnections_applicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_link_service_endpoint_connections", "test")
+	r := PrivateLinkServiceEndpointConnectionDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.applicationGateway(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("service_name").Exists(),
+				check.That(data.ResourceName).Key("private_endpoint_connections.0.status").HasValue("Pending"),
+				check.That(data.ResourceName).Key("private_endpoint_connections.0.connection_id").Exists(),
+				check.That(data.ResourceName).Key("private_endpoint_connections.0.connection_name").Exists(),
+				check.That(data.ResourceName).Key("private_endpoint_connections.0.private_endpoint_id").Exists(),
+			),
+		},
+	})
+}
+
 func (PrivateLinkServiceEndpointConnectionDataSource) complete(data acceptance.TestData) string {
 	// azurerm_private_link_service_endpoint_connections depends on azurerm_private_endpoint, we deliberately introduce
 	// this dependency here via reference, rather than using `depends_on` since `depends_on` on data source will make
@@ -45,3 +63,7 @@ data "azurerm_private_link_service_endpoint_connections" "test" {
 }
 `, PrivateLinkServiceResource{}.basic(data))
 }
+
+func (PrivateLinkServiceEndpointConnectionDataSource) applicationGateway(data acceptance.TestData) string {
+	return ApplicationGatewayPrivateEndpointConnectionResource{}.template(data)
+}
@@ -127,6 +127,37 @@ func resourcePublicIp() *schema.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"ddos_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protection_coverage": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.DdosSettingsProtectionCoverageBasic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosSettingsProtectionCoverageBasic),
+								string(network.DdosSettingsProtectionCoverageStandard),
+							}, false),
+						},
+
+						"ddos_custom_policy_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.DdosCustomPolicyID,
+						},
+
+						"protected_ip_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"zones": azure.SchemaSingleZone(),
 
 			"tags": tags.Schema(),
@@ -163,6 +194,17 @@ func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	ddosSettings, err := expandPublicIpDdosSettings(d.Get("ddos_settings").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if ddosSettings == nil && !d.IsNewResource() && d.HasChange("ddos_settings") {
+		// the block's been removed, so fall back to the Basic protection of the platform
+		ddosSettings = &network.DdosSettings{
+			ProtectionCoverage: network.DdosSettingsProtectionCoverageBasic,
+		}
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
@@ -186,6 +228,7 @@ func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 			PublicIPAllocationMethod: network.IPAllocationMethod(ipAllocationMethod),
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
+			DdosSettings:             ddosSettings,
 		},
 		Tags:  tags.Expand(t),
 		Zones: zones,
@@ -287,6 +330,12 @@ func resourcePublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		// a block containing only the defaults is kept when it was previously configured, to avoid a diff
+		ddosSettingsConfigured := len(d.Get("ddos_settings").([]interface{})) > 0
+		if err := d.Set("ddos_settings", flattenPublicIpDdosSettings(props.DdosSettings, ddosSettingsConfigured)); err != nil {
+			return fmt.Errorf("Error setting `ddos_settings`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -315,3 +364,64 @@ func resourcePublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandPublicIpDdosSettings(input []interface{}) (*network.DdosSettings, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	coverage := network.DdosSettingsProtectionCoverage(v["protection_coverage"].(string))
+	settings := network.DdosSettings{
+		ProtectionCoverage: coverage,
+		ProtectedIP:        utils.Bool(v["protected_ip_enabled"].(bool)),
+	}
+
+	if policyId := v["ddos_custom_policy_id"].(string); policyId != "" {
+		if coverage != network.DdosSettingsProtectionCoverageStandard {
+			return nil, fmt.Errorf("`protection_coverage` must be `%s` when a `ddos_custom_policy_id` is specified", string(network.DdosSettingsProtectionCoverageStandard))
+		}
+
+		settings.DdosCustomPolicy = &network.SubResource{
+			ID: utils.String(policyId),
+		}
+	}
+
+	return &settings, nil
+}
+
+func flattenPublicIpDdosSettings(input *network.DdosSettings, configured bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policyId := ""
+	if input.DdosCustomPolicy != nil && input.DdosCustomPolicy.ID != nil {
+		policyId = *input.DdosCustomPolicy.ID
+	}
+
+	protectedIp := false
+	if input.ProtectedIP != nil {
+		protectedIp = *input.ProtectedIP
+	}
+
+	coverage := string(input.ProtectionCoverage)
+	if coverage == "" {
+		coverage = string(network.DdosSettingsProtectionCoverageBasic)
+	}
+
+	// the defaults are returned for every Public IP, so only surface the block when it's been configured
+	// or when it's been changed from the defaults
+	if !configured && coverage == string(network.DdosSettingsProtectionCoverageBasic) && policyId == "" && !protectedIp {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"protection_coverage":   coverage,
+			"ddos_custom_policy_id": policyId,
+			"protected_ip_enabled":  protectedIp,
+		},
+	}
+}
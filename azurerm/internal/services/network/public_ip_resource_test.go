@@ -177,6 +177,49 @@ func TestAccPublicIpStatic_standard(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_ddosSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.standard(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ddos_settings.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ddosSettings(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ddos_settings.0.protection_coverage").HasValue("Standard"),
+				check.That(data.ResourceName).Key("ddos_settings.0.protected_ip_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ddosSettingsDefaults(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ddos_settings.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ddos_settings.0.protection_coverage").HasValue("Basic"),
+			),
+		},
+		// a block containing only the defaults can't be distinguished from no block when importing
+		data.ImportStep("ddos_settings"),
+		{
+			Config: r.standard(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ddos_settings.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIpStatic_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
 	r := PublicIPResource{}
@@ -495,6 +538,67 @@ resource "azurerm_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (PublicIPResource) ddosSettings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_ddos_custom_policy" "test" {
+  name                = "acctest-ddoscp-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  protocol_custom_setting {
+    protocol                     = "Syn"
+    trigger_sensitivity_override = "Relaxed"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ddos_settings {
+    protection_coverage   = "Standard"
+    ddos_custom_policy_id = azurerm_network_ddos_custom_policy.test.id
+    protected_ip_enabled  = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (PublicIPResource) ddosSettingsDefaults(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ddos_settings {}
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (PublicIPResource) standardPrefix(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
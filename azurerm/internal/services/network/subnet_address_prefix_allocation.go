@@ -0,0 +1,197 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// subnetAddressRange is an inclusive range of IPv4 addresses - these are held as uint64's so that the end of the
+// address space (255.255.255.255) can be stepped over without overflowing
+type subnetAddressRange struct {
+	start uint64
+	end   uint64
+}
+
+func (r subnetAddressRange) overlaps(other subnetAddressRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+// parseSubnetAddressPrefix parses an IPv4 CIDR into a range of addresses, returning nil for an IPv6 CIDR
+func parseSubnetAddressPrefix(input string) (*subnetAddressRange, error) {
+	_, ipNet, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a CIDR: %+v", input, err)
+	}
+
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return nil, nil
+	}
+
+	ones, _ := ipNet.Mask.Size()
+	start := uint64(ip[0])<<24 | uint64(ip[1])<<16 | uint64(ip[2])<<8 | uint64(ip[3])
+	return &subnetAddressRange{
+		start: start,
+		end:   start + (uint64(1) << uint(32-ones)) - 1,
+	}, nil
+}
+
+func formatSubnetAddressPrefix(start uint64, prefixLength int) string {
+	ip := net.IPv4(byte(start>>24), byte(start>>16), byte(start>>8), byte(start))
+	return fmt.Sprintf("%s/%d", ip.String(), prefixLength)
+}
+
+// allocateSubnetAddressPrefixes returns a free IPv4 CIDR within the address space for each of the requested prefix
+// lengths (in the order requested) which overlaps neither the used address prefixes nor any of the other results.
+// The largest blocks are allocated first to limit fragmentation - and IPv6 CIDRs are ignored.
+func allocateSubnetAddressPrefixes(addressSpace []string, used []string, prefixLengths []int) ([]string, error) {
+	space := make([]subnetAddressRange, 0)
+	for _, v := range addressSpace {
+		addressRange, err := parseSubnetAddressPrefix(v)
+		if err != nil {
+			return nil, err
+		}
+		if addressRange != nil {
+			space = append(space, *addressRange)
+		}
+	}
+	if len(space) == 0 {
+		return nil, fmt.Errorf("the address space %q contains no IPv4 address prefixes", strings.Join(addressSpace, ", "))
+	}
+
+	allocated := make([]subnetAddressRange, 0)
+	for _, v := range used {
+		addressRange, err := parseSubnetAddressPrefix(v)
+		if err != nil {
+			return nil, err
+		}
+		if addressRange != nil {
+			allocated = append(allocated, *addressRange)
+		}
+	}
+
+	order := make([]int, len(prefixLengths))
+	for i := range prefixLengths {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return prefixLengths[order[i]] < prefixLengths[order[j]]
+	})
+
+	results := make([]string, len(prefixLengths))
+	for _, i := range order {
+		prefixLength := prefixLengths[i]
+		if prefixLength < 0 || prefixLength > 32 {
+			return nil, fmt.Errorf("expected the prefix length to be between 0 and 32 but got %d", prefixLength)
+		}
+
+		addressRange := findFreeSubnetAddressRange(space, allocated, prefixLength)
+		if addressRange == nil {
+			return nil, fmt.Errorf("no free /%d address prefix is available within the address space %q", prefixLength, strings.Join(addressSpace, ", "))
+		}
+
+		allocated = append(allocated, *addressRange)
+		results[i] = formatSubnetAddressPrefix(addressRange.start, prefixLength)
+	}
+
+	return results, nil
+}
+
+func findFreeSubnetAddressRange(space []subnetAddressRange, allocated []subnetAddressRange, prefixLength int) *subnetAddressRange {
+	size := uint64(1) << uint(32-prefixLength)
+	alignUp := func(input uint64) uint64 {
+		return (input + size - 1) / size * size
+	}
+
+	for _, block := range space {
+		candidate := subnetAddressRange{start: alignUp(block.start)}
+		candidate.end = candidate.start + size - 1
+
+		for candidate.end <= block.end {
+			overlapping := false
+			for _, other := range allocated {
+				if candidate.overlaps(other) {
+					// skip past the allocated range rather than stepping through it one block at a time
+					candidate.start = alignUp(other.end + 1)
+					candidate.end = candidate.start + size - 1
+					overlapping = true
+					break
+				}
+			}
+
+			if !overlapping {
+				return &candidate
+			}
+		}
+	}
+
+	return nil
+}
+
+// subnetAddressPrefixReservations tracks the address prefixes reserved by `azurerm_subnet_address_prefix_reservation`
+// within this process (keyed by the lower-cased Virtual Network ID), since these only exist in the state. Allocations
+// themselves are serialized by locking on the Virtual Network's name. Since this only lives as long as the provider
+// process, reservations made in an earlier apply are only known about once they've been read again.
+var subnetAddressPrefixReservations = &subnetAddressPrefixReservationStore{
+	reservations: make(map[string]map[string]struct{}),
+}
+
+type subnetAddressPrefixReservationStore struct {
+	sync.Mutex
+	reservations map[string]map[string]struct{}
+}
+
+// add registers the reservation, returning false if this address prefix was already reserved
+func (s *subnetAddressPrefixReservationStore) add(virtualNetworkId, addressPrefix string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	key := strings.ToLower(virtualNetworkId)
+	if _, ok := s.reservations[key]; !ok {
+		s.reservations[key] = make(map[string]struct{})
+	}
+	if _, ok := s.reservations[key][addressPrefix]; ok {
+		return false
+	}
+	s.reservations[key][addressPrefix] = struct{}{}
+	return true
+}
+
+// reserve allocates and registers a free address prefix of the specified length, excluding both the address prefixes
+// which are in use and those already reserved. Since a reservation can be registered (by reading it) after the free
+// address prefixes were determined, the allocation is retried with the updated reservations when that happens.
+func (s *subnetAddressPrefixReservationStore) reserve(virtualNetworkId string, addressSpace []string, used []string, prefixLength int) (string, error) {
+	for {
+		allocated := append(append([]string{}, used...), s.list(virtualNetworkId)...)
+		addressPrefixes, err := allocateSubnetAddressPrefixes(addressSpace, allocated, []int{prefixLength})
+		if err != nil {
+			return "", err
+		}
+
+		if s.add(virtualNetworkId, addressPrefixes[0]) {
+			return addressPrefixes[0], nil
+		}
+	}
+}
+
+func (s *subnetAddressPrefixReservationStore) remove(virtualNetworkId, addressPrefix string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.reservations[strings.ToLower(virtualNetworkId)], addressPrefix)
+}
+
+func (s *subnetAddressPrefixReservationStore) list(virtualNetworkId string) []string {
+	s.Lock()
+	defer s.Unlock()
+
+	results := make([]string, 0)
+	for addressPrefix := range s.reservations[strings.ToLower(virtualNetworkId)] {
+		results = append(results, addressPrefix)
+	}
+	sort.Strings(results)
+	return results
+}
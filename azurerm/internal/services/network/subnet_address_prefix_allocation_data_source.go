@@ -0,0 +1,156 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceSubnetAddressPrefixAllocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSubnetAddressPrefixAllocationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+				ExactlyOneOf: []string{"virtual_network_id", "address_space"},
+			},
+
+			"address_space": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"virtual_network_id", "address_space"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+
+			"prefix_lengths": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(8, 29),
+				},
+			},
+
+			"exclude_address_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+
+			"address_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSubnetAddressPrefixAllocationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	addressSpace := *utils.ExpandStringSlice(d.Get("address_space").([]interface{}))
+	used := *utils.ExpandStringSlice(d.Get("exclude_address_prefixes").([]interface{}))
+
+	var id *parse.VirtualNetworkId
+	if v, ok := d.GetOk("virtual_network_id"); ok {
+		var err error
+		id, err = parse.VirtualNetworkID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		vnet, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(vnet.Response) {
+				return fmt.Errorf("%s was not found", *id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		vnetAddressSpace, vnetUsed := subnetAddressPrefixesForVirtualNetwork(vnet)
+		addressSpace = vnetAddressSpace
+		used = append(used, vnetUsed...)
+	}
+
+	prefixLengths := make([]int, 0)
+	for _, v := range d.Get("prefix_lengths").([]interface{}) {
+		prefixLengths = append(prefixLengths, v.(int))
+	}
+
+	addressPrefixes, err := allocateSubnetAddressPrefixes(addressSpace, used, prefixLengths)
+	if err != nil {
+		return fmt.Errorf("allocating Subnet Address Prefixes: %+v", err)
+	}
+
+	if id != nil {
+		d.SetId(id.ID())
+		d.Set("virtual_network_id", id.ID())
+	} else {
+		d.SetId(time.Now().UTC().String())
+	}
+
+	if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+		return fmt.Errorf("setting `address_prefixes`: %+v", err)
+	}
+
+	return nil
+}
+
+// subnetAddressPrefixesForVirtualNetwork returns the address space of the Virtual Network and the address prefixes
+// already used by its Subnets
+func subnetAddressPrefixesForVirtualNetwork(vnet network.VirtualNetwork) ([]string, []string) {
+	addressSpace := make([]string, 0)
+	used := make([]string, 0)
+
+	props := vnet.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return addressSpace, used
+	}
+
+	if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+		addressSpace = *props.AddressSpace.AddressPrefixes
+	}
+
+	if props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+			if subnet.AddressPrefix != nil && *subnet.AddressPrefix != "" {
+				used = append(used, *subnet.AddressPrefix)
+			}
+			if subnet.AddressPrefixes != nil {
+				used = append(used, *subnet.AddressPrefixes...)
+			}
+		}
+	}
+
+	return addressSpace, used
+}
@@ -0,0 +1,92 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type SubnetAddressPrefixAllocationDataSource struct {
+}
+
+func TestAccDataSourceSubnetAddressPrefixAllocation_virtualNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_address_prefix_allocation", "test")
+	r := SubnetAddressPrefixAllocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.virtualNetwork(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.2.0/26"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.1.0/24"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubnetAddressPrefixAllocation_addressSpace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_address_prefix_allocation", "test")
+	r := SubnetAddressPrefixAllocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.addressSpace(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.0.128/25"),
+			),
+		},
+	})
+}
+
+func (SubnetAddressPrefixAllocationDataSource) virtualNetwork(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+
+data "azurerm_subnet_address_prefix_allocation" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_lengths     = [26, 24]
+
+  depends_on = [azurerm_subnet.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (SubnetAddressPrefixAllocationDataSource) addressSpace() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subnet_address_prefix_allocation" "test" {
+  address_space            = ["10.0.0.0/24"]
+  exclude_address_prefixes = ["10.0.0.0/25"]
+  prefix_lengths           = [25]
+}
+`
+}
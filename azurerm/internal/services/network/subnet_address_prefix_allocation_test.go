@@ -0,0 +1,206 @@
+package network
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestAllocateSubnetAddressPrefixes(t *testing.T) {
+	testData := []struct {
+		name          string
+		addressSpace  []string
+		used          []string
+		prefixLengths []int
+		expected      []string
+	}{
+		{
+			name:          "empty address space",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{},
+			prefixLengths: []int{24},
+			expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			name:          "skips used subnets",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{"10.0.0.0/24", "10.0.1.0/25"},
+			prefixLengths: []int{24},
+			expected:      []string{"10.0.2.0/24"},
+		},
+		{
+			name:          "fills gaps between used subnets",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{"10.0.0.0/24", "10.0.1.0/25"},
+			prefixLengths: []int{25},
+			expected:      []string{"10.0.1.128/25"},
+		},
+		{
+			name:          "multiple requests don't overlap one another",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{},
+			prefixLengths: []int{24, 24, 26},
+			expected:      []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/26"},
+		},
+		{
+			name:          "larger blocks are allocated first but returned in the order requested",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{},
+			prefixLengths: []int{28, 24},
+			expected:      []string{"10.0.1.0/28", "10.0.0.0/24"},
+		},
+		{
+			name:          "moves on to the next address space",
+			addressSpace:  []string{"10.0.0.0/24", "10.1.0.0/16"},
+			used:          []string{"10.0.0.0/25"},
+			prefixLengths: []int{24},
+			expected:      []string{"10.1.0.0/24"},
+		},
+		{
+			name:          "unaligned address space",
+			addressSpace:  []string{"10.0.0.64/26", "10.0.0.128/25"},
+			used:          []string{},
+			prefixLengths: []int{25},
+			expected:      []string{"10.0.0.128/25"},
+		},
+		{
+			name:          "ipv6 prefixes are ignored",
+			addressSpace:  []string{"ace:cab:deca::/48", "10.0.0.0/16"},
+			used:          []string{"ace:cab:deca:deed::/64", "10.0.0.0/24"},
+			prefixLengths: []int{24},
+			expected:      []string{"10.0.1.0/24"},
+		},
+		{
+			name:          "end of the ipv4 address space",
+			addressSpace:  []string{"255.255.255.0/24"},
+			used:          []string{"255.255.255.0/25"},
+			prefixLengths: []int{25},
+			expected:      []string{"255.255.255.128/25"},
+		},
+		{
+			name:          "address space exhausted",
+			addressSpace:  []string{"10.0.0.0/24"},
+			used:          []string{"10.0.0.0/25", "10.0.0.192/26"},
+			prefixLengths: []int{25},
+			expected:      nil,
+		},
+		{
+			name:          "requests exhaust the address space",
+			addressSpace:  []string{"10.0.0.0/24"},
+			used:          []string{},
+			prefixLengths: []int{25, 25, 25},
+			expected:      nil,
+		},
+		{
+			name:          "no ipv4 address space",
+			addressSpace:  []string{"ace:cab:deca::/48"},
+			used:          []string{},
+			prefixLengths: []int{24},
+			expected:      nil,
+		},
+		{
+			name:          "invalid used prefix",
+			addressSpace:  []string{"10.0.0.0/16"},
+			used:          []string{"10.0.0.0"},
+			prefixLengths: []int{24},
+			expected:      nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := allocateSubnetAddressPrefixes(v.addressSpace, v.used, v.prefixLengths)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but got %+v", v.name, actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.name, err)
+		}
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestSubnetAddressPrefixReservationStore(t *testing.T) {
+	store := &subnetAddressPrefixReservationStore{
+		reservations: make(map[string]map[string]struct{}),
+	}
+	vnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+
+	if !store.add(vnetId, "10.0.1.0/24") || !store.add(vnetId, "10.0.0.0/24") {
+		t.Fatalf("Expected the reservations to be added")
+	}
+	if store.add(vnetId, "10.0.0.0/24") {
+		t.Fatalf("Expected the duplicate reservation not to be added")
+	}
+
+	if actual := store.list(vnetId); !reflect.DeepEqual(actual, []string{"10.0.0.0/24", "10.0.1.0/24"}) {
+		t.Fatalf("Expected two reservations but got %+v", actual)
+	}
+
+	store.remove(vnetId, "10.0.1.0/24")
+	if actual := store.list(vnetId); !reflect.DeepEqual(actual, []string{"10.0.0.0/24"}) {
+		t.Fatalf("Expected one reservation but got %+v", actual)
+	}
+
+	// Virtual Network ID's are case-insensitive
+	if actual := store.list("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKS/NETWORK1"); len(actual) != 1 {
+		t.Fatalf("Expected one reservation but got %+v", actual)
+	}
+
+	if actual := store.list("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2"); len(actual) != 0 {
+		t.Fatalf("Expected no reservations but got %+v", actual)
+	}
+}
+
+func TestSubnetAddressPrefixReservationStoreReserve(t *testing.T) {
+	store := &subnetAddressPrefixReservationStore{
+		reservations: make(map[string]map[string]struct{}),
+	}
+	vnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	addressSpace := []string{"10.0.0.0/24"}
+	used := []string{"10.0.0.0/26"}
+
+	// a reservation registered by reading it is excluded from the allocation
+	store.add(vnetId, "10.0.0.64/26")
+
+	// reservations are made concurrently, so each of the free Address Prefixes should be reserved exactly once
+	results := make(chan string, 4)
+	errs := make(chan error, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			addressPrefix, err := store.reserve(vnetId, addressSpace, used, 26)
+			if err != nil {
+				errs <- err
+				return
+			}
+			results <- addressPrefix
+		}()
+	}
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	reserved := make([]string, 0)
+	for addressPrefix := range results {
+		reserved = append(reserved, addressPrefix)
+	}
+	sort.Strings(reserved)
+	if !reflect.DeepEqual(reserved, []string{"10.0.0.128/26", "10.0.0.192/26"}) {
+		t.Fatalf("Expected the two free Address Prefixes to be reserved but got %+v", reserved)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected two reservations to fail since the Address Space is exhausted but got %d", len(errs))
+	}
+}
@@ -0,0 +1,170 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceSubnetAddressPrefixReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSubnetAddressPrefixReservationCreate,
+		Read:   resourceSubnetAddressPrefixReservationRead,
+		Delete: resourceSubnetAddressPrefixReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, err := parseSubnetAddressPrefixReservationID(d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+			},
+
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(8, 29),
+			},
+
+			"address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSubnetAddressPrefixReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vnetId, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Subnets lock on the Virtual Network name too, so nothing else can claim a range between reading and reserving it
+	locks.ByName(vnetId.Name, VirtualNetworkResourceName)
+	defer locks.UnlockByName(vnetId.Name, VirtualNetworkResourceName)
+
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *vnetId, err)
+	}
+
+	addressSpace, used := subnetAddressPrefixesForVirtualNetwork(vnet)
+	addressPrefix, err := subnetAddressPrefixReservations.reserve(vnetId.ID(), addressSpace, used, d.Get("prefix_length").(int))
+	if err != nil {
+		return fmt.Errorf("allocating an Address Prefix within %s: %+v", *vnetId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", vnetId.ID(), addressPrefix))
+
+	return resourceSubnetAddressPrefixReservationRead(d, meta)
+}
+
+func resourceSubnetAddressPrefixReservationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseSubnetAddressPrefixReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vnet, err := client.Get(ctx, id.VirtualNetwork.ResourceGroup, id.VirtualNetwork.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			log.Printf("[DEBUG] %s was not found - removing Address Prefix Reservation %q from state", id.VirtualNetwork, id.AddressPrefix)
+			subnetAddressPrefixReservations.remove(id.VirtualNetwork.ID(), id.AddressPrefix)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.VirtualNetwork, err)
+	}
+
+	// register the reservation so that other allocations in this run exclude it, even when it was made in an earlier run
+	subnetAddressPrefixReservations.add(id.VirtualNetwork.ID(), id.AddressPrefix)
+
+	d.Set("virtual_network_id", id.VirtualNetwork.ID())
+	d.Set("address_prefix", id.AddressPrefix)
+	d.Set("prefix_length", id.PrefixLength)
+
+	return nil
+}
+
+func resourceSubnetAddressPrefixReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseSubnetAddressPrefixReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualNetwork.Name, VirtualNetworkResourceName)
+	defer locks.UnlockByName(id.VirtualNetwork.Name, VirtualNetworkResourceName)
+
+	subnetAddressPrefixReservations.remove(id.VirtualNetwork.ID(), id.AddressPrefix)
+
+	return nil
+}
+
+type subnetAddressPrefixReservationId struct {
+	VirtualNetwork parse.VirtualNetworkId
+	AddressPrefix  string
+	PrefixLength   int
+}
+
+func parseSubnetAddressPrefixReservationID(input string) (*subnetAddressPrefixReservationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{virtualNetworkId}|{addressPrefix}` but got %q", input)
+	}
+
+	vnetId, err := parse.VirtualNetworkID(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	ip, ipNet, err := net.ParseCIDR(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a CIDR: %+v", segments[1], err)
+	}
+	if ip.To4() == nil || !ip.Equal(ipNet.IP) {
+		return nil, fmt.Errorf("expected %q to be an IPv4 CIDR without any host bits set", segments[1])
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+
+	return &subnetAddressPrefixReservationId{
+		VirtualNetwork: *vnetId,
+		AddressPrefix:  segments[1],
+		PrefixLength:   prefixLength,
+	}, nil
+}
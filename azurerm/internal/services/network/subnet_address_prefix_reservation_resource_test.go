@@ -0,0 +1,141 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SubnetAddressPrefixReservationResource struct {
+}
+
+func TestAccSubnetAddressPrefixReservation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_address_prefix_reservation", "test")
+	r := SubnetAddressPrefixReservationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefix").HasValue("10.0.1.0/24"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubnetAddressPrefixReservation_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_address_prefix_reservation", "test")
+	r := SubnetAddressPrefixReservationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_subnet_address_prefix_reservation.other").ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azurerm_subnet.reserved", "address_prefixes.0", data.ResourceName, "address_prefix"),
+				func(s *terraform.State) error {
+					first := s.RootModule().Resources[data.ResourceName].Primary.Attributes["address_prefix"]
+					second := s.RootModule().Resources["azurerm_subnet_address_prefix_reservation.other"].Primary.Attributes["address_prefix"]
+					if first == second {
+						return fmt.Errorf("expected the reservations to be different but both were %q", first)
+					}
+					return nil
+				},
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (SubnetAddressPrefixReservationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	// reservations only exist in the state, so this checks the Virtual Network they belong to still exists
+	id, err := parse.VirtualNetworkID(state.Attributes["virtual_network_id"])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VnetClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (SubnetAddressPrefixReservationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r SubnetAddressPrefixReservationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_address_prefix_reservation" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_length      = 24
+
+  depends_on = [azurerm_subnet.test]
+}
+`, r.template(data))
+}
+
+func (r SubnetAddressPrefixReservationResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_address_prefix_reservation" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_length      = 24
+
+  depends_on = [azurerm_subnet.test]
+}
+
+resource "azurerm_subnet_address_prefix_reservation" "other" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_length      = 24
+
+  depends_on = [azurerm_subnet.test]
+}
+
+resource "azurerm_subnet" "reserved" {
+  name                 = "reserved"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [azurerm_subnet_address_prefix_reservation.test.address_prefix]
+}
+`, r.template(data))
+}
@@ -0,0 +1,401 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const networkVirtualApplianceResourceName = "azurerm_virtual_hub_network_virtual_appliance"
+
+func resourceVirtualHubNetworkVirtualAppliance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualHubNetworkVirtualApplianceCreateUpdate,
+		Read:   resourceVirtualHubNetworkVirtualApplianceRead,
+		Update: resourceVirtualHubNetworkVirtualApplianceCreateUpdate,
+		Delete: resourceVirtualHubNetworkVirtualApplianceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.NetworkVirtualApplianceID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vendor": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"bundled_scale_unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"market_place_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"asn": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+
+			"boot_strap_configuration_blobs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+
+			"cloud_init_configuration_blobs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"cloud_init_configuration"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+
+			"cloud_init_configuration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"cloud_init_configuration_blobs"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"network_interface": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"site_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualHubNetworkVirtualApplianceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkVirtualApplianceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_network_virtual_appliance", id.ID())
+		}
+	}
+
+	hubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Network Virtual Appliance is deployed into the Virtual Hub, which can't be updated at the same time
+	locks.ByName(hubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(hubId.Name, virtualHubResourceName)
+
+	locks.ByName(id.Name, networkVirtualApplianceResourceName)
+	defer locks.UnlockByName(id.Name, networkVirtualApplianceResourceName)
+
+	props := network.VirtualAppliancePropertiesFormat{
+		NvaSku:                      expandVirtualHubNetworkVirtualApplianceSku(d.Get("sku").([]interface{})),
+		VirtualHub:                  &network.SubResource{ID: utils.String(hubId.ID())},
+		VirtualApplianceAsn:         utils.Int64(int64(d.Get("asn").(int))),
+		BootStrapConfigurationBlobs: utils.ExpandStringSlice(d.Get("boot_strap_configuration_blobs").([]interface{})),
+		CloudInitConfigurationBlobs: utils.ExpandStringSlice(d.Get("cloud_init_configuration_blobs").([]interface{})),
+	}
+
+	if v := d.Get("cloud_init_configuration").(string); v != "" {
+		props.CloudInitConfiguration = utils.String(v)
+	}
+
+	parameters := network.VirtualAppliance{
+		Location:                         utils.String(location.Normalize(d.Get("location").(string))),
+		VirtualAppliancePropertiesFormat: &props,
+		Tags:                             tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubNetworkVirtualApplianceRead(d, meta)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.VirtualAppliancePropertiesFormat; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			hubId, err := parse.VirtualHubID(*props.VirtualHub.ID)
+			if err != nil {
+				return err
+			}
+			virtualHubId = hubId.ID()
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := d.Set("sku", flattenVirtualHubNetworkVirtualApplianceSku(props.NvaSku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		asn := 0
+		if props.VirtualApplianceAsn != nil {
+			asn = int(*props.VirtualApplianceAsn)
+		}
+		d.Set("asn", asn)
+
+		if err := d.Set("boot_strap_configuration_blobs", utils.FlattenStringSlice(props.BootStrapConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `boot_strap_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("cloud_init_configuration_blobs", utils.FlattenStringSlice(props.CloudInitConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `cloud_init_configuration_blobs`: %+v", err)
+		}
+
+		// `cloud_init_configuration` isn't returned by the API, so we keep the value from the config
+
+		if err := d.Set("network_interface", flattenVirtualHubNetworkVirtualApplianceNics(props.VirtualApplianceNics)); err != nil {
+			return fmt.Errorf("setting `network_interface`: %+v", err)
+		}
+
+		if err := d.Set("site_ids", flattenNetworkSubResourceID(props.VirtualApplianceSites)); err != nil {
+			return fmt.Errorf("setting `site_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualAppliancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(hubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(hubId.Name, virtualHubResourceName)
+
+	locks.ByName(id.Name, networkVirtualApplianceResourceName)
+	defer locks.UnlockByName(id.Name, networkVirtualApplianceResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualHubNetworkVirtualApplianceSku(input []interface{}) *network.VirtualApplianceSkuProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.VirtualApplianceSkuProperties{
+		Vendor:             utils.String(v["vendor"].(string)),
+		BundledScaleUnit:   utils.String(v["bundled_scale_unit"].(string)),
+		MarketPlaceVersion: utils.String(v["market_place_version"].(string)),
+	}
+}
+
+func flattenVirtualHubNetworkVirtualApplianceSku(input *network.VirtualApplianceSkuProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	vendor := ""
+	if input.Vendor != nil {
+		vendor = *input.Vendor
+	}
+
+	bundledScaleUnit := ""
+	if input.BundledScaleUnit != nil {
+		bundledScaleUnit = *input.BundledScaleUnit
+	}
+
+	marketPlaceVersion := ""
+	if input.MarketPlaceVersion != nil {
+		marketPlaceVersion = *input.MarketPlaceVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vendor":               vendor,
+			"bundled_scale_unit":   bundledScaleUnit,
+			"market_place_version": marketPlaceVersion,
+		},
+	}
+}
+
+func flattenVirtualHubNetworkVirtualApplianceNics(input *[]network.VirtualApplianceNicProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIPAddress := ""
+		if item.PrivateIPAddress != nil {
+			privateIPAddress = *item.PrivateIPAddress
+		}
+
+		publicIPAddress := ""
+		if item.PublicIPAddress != nil {
+			publicIPAddress = *item.PublicIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"private_ip_address": privateIPAddress,
+			"public_ip_address":  publicIPAddress,
+		})
+	}
+
+	return results
+}
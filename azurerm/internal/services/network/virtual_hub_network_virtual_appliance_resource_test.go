@@ -0,0 +1,209 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualHubNetworkVirtualApplianceResource struct {
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("boot_strap_configuration_blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t VirtualHubNetworkVirtualApplianceResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualAppliancesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                = "acctest-nva-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_hub_id      = azurerm_virtual_hub.test.id
+  asn                 = 65000
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "import" {
+  name                = azurerm_virtual_hub_network_virtual_appliance.test.name
+  resource_group_name = azurerm_virtual_hub_network_virtual_appliance.test.resource_group_name
+  location            = azurerm_virtual_hub_network_virtual_appliance.test.location
+  virtual_hub_id      = azurerm_virtual_hub_network_virtual_appliance.test.virtual_hub_id
+  asn                 = azurerm_virtual_hub_network_virtual_appliance.test.asn
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "bootstrap"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "bootstrap.cfg"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "hostname nva"
+}
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                           = "acctest-nva-%d"
+  resource_group_name            = azurerm_resource_group.test.name
+  location                       = azurerm_resource_group.test.location
+  virtual_hub_id                 = azurerm_virtual_hub.test.id
+  asn                            = 65000
+  boot_strap_configuration_blobs = [azurerm_storage_blob.test.url]
+  cloud_init_configuration       = "#cloud-config"
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nva-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
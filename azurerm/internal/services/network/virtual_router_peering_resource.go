@@ -0,0 +1,179 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualRouterPeering() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualRouterPeeringCreateUpdate,
+		Read:   resourceVirtualRouterPeeringRead,
+		Update: resourceVirtualRouterPeeringCreateUpdate,
+		Delete: resourceVirtualRouterPeeringDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualRouterPeeringID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_router_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualRouterID,
+			},
+
+			"peer_asn": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+
+			"peer_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func resourceVirtualRouterPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRouterPeeringsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	routerId, err := parse.VirtualRouterID(d.Get("virtual_router_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualRouterPeeringID(routerId.SubscriptionId, routerId.ResourceGroup, routerId.Name, d.Get("name").(string))
+
+	locks.ByName(id.VirtualRouterName, virtualRouterResourceName)
+	defer locks.UnlockByName(id.VirtualRouterName, virtualRouterResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualRouterName, id.PeeringName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_router_peering", id.ID())
+		}
+	}
+
+	parameters := network.VirtualRouterPeering{
+		Name: utils.String(id.PeeringName),
+		VirtualRouterPeeringProperties: &network.VirtualRouterPeeringProperties{
+			PeerAsn: utils.Int64(int64(d.Get("peer_asn").(int))),
+			PeerIP:  utils.String(d.Get("peer_ip").(string)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualRouterName, id.PeeringName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualRouterPeeringRead(d, meta)
+}
+
+func resourceVirtualRouterPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRouterPeeringsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualRouterPeeringID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualRouterName, id.PeeringName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PeeringName)
+	d.Set("virtual_router_id", parse.NewVirtualRouterID(id.SubscriptionId, id.ResourceGroup, id.VirtualRouterName).ID())
+
+	if props := resp.VirtualRouterPeeringProperties; props != nil {
+		peerAsn := 0
+		if props.PeerAsn != nil {
+			peerAsn = int(*props.PeerAsn)
+		}
+		d.Set("peer_asn", peerAsn)
+		d.Set("peer_ip", props.PeerIP)
+	}
+
+	return nil
+}
+
+func resourceVirtualRouterPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRouterPeeringsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualRouterPeeringID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualRouterName, virtualRouterResourceName)
+	defer locks.UnlockByName(id.VirtualRouterName, virtualRouterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualRouterName, id.PeeringName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const virtualRouterResourceName = "azurerm_virtual_router"
+
+func resourceVirtualRouter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualRouterCreateUpdate,
+		Read:   resourceVirtualRouterRead,
+		Update: resourceVirtualRouterCreateUpdate,
+		Delete: resourceVirtualRouterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualRouterID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"hosted_subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SubnetID,
+			},
+
+			"virtual_router_asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"virtual_router_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualRouterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRoutersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualRouterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_router", id.ID())
+		}
+	}
+
+	subnetId, err := parse.SubnetID(d.Get("hosted_subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Virtual Router is deployed into the Subnet, so other changes to the Virtual Network must wait
+	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnetId.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnetId.Name, SubnetResourceName)
+
+	locks.ByName(id.Name, virtualRouterResourceName)
+	defer locks.UnlockByName(id.Name, virtualRouterResourceName)
+
+	parameters := network.VirtualRouter{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		VirtualRouterPropertiesFormat: &network.VirtualRouterPropertiesFormat{
+			HostedSubnet: &network.SubResource{
+				ID: utils.String(subnetId.ID()),
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualRouterRead(d, meta)
+}
+
+func resourceVirtualRouterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRoutersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualRouterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.VirtualRouterPropertiesFormat; props != nil {
+		hostedSubnetId := ""
+		if props.HostedSubnet != nil && props.HostedSubnet.ID != nil {
+			hostedSubnetId = *props.HostedSubnet.ID
+		}
+		d.Set("hosted_subnet_id", hostedSubnetId)
+
+		asn := 0
+		if props.VirtualRouterAsn != nil {
+			asn = int(*props.VirtualRouterAsn)
+		}
+		d.Set("virtual_router_asn", asn)
+
+		if err := d.Set("virtual_router_ips", utils.FlattenStringSlice(props.VirtualRouterIps)); err != nil {
+			return fmt.Errorf("setting `virtual_router_ips`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualRouterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualRoutersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualRouterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subnetId, err := parse.SubnetID(d.Get("hosted_subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnetId.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnetId.Name, SubnetResourceName)
+
+	locks.ByName(id.Name, virtualRouterResourceName)
+	defer locks.UnlockByName(id.Name, virtualRouterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
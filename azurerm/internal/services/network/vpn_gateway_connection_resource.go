@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -17,9 +18,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -146,6 +150,12 @@ func resourceVPNGatewayConnection() *schema.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						"shared_key_key_vault_secret_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+
 						"bgp_enabled": {
 							Type:     schema.TypeBool,
 							ForceNew: true,
@@ -275,6 +285,21 @@ func resourceVPNGatewayConnection() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"connection_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ingress_bytes_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"egress_bytes_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -284,6 +309,7 @@ func resourceVPNGatewayConnection() *schema.Resource {
 
 func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VpnConnectionsClient
+	keyVaultClient := meta.(*clients.Client).KeyVault.ManagementClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -306,6 +332,11 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, me
 		}
 	}
 
+	vpnLinkConnections, err := expandVpnGatewayConnectionVpnSiteLinkConnections(ctx, keyVaultClient, d.Get("vpn_link").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	locks.ByName(gatewayId.Name, VPNGatewayResourceName)
 	defer locks.UnlockByName(gatewayId.Name, VPNGatewayResourceName)
 
@@ -316,7 +347,7 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, me
 			RemoteVpnSite: &network.SubResource{
 				ID: utils.String(d.Get("remote_vpn_site_id").(string)),
 			},
-			VpnLinkConnections:   expandVpnGatewayConnectionVpnSiteLinkConnections(d.Get("vpn_link").([]interface{})),
+			VpnLinkConnections:   vpnLinkConnections,
 			RoutingConfiguration: expandVpnGatewayConnectionRoutingConfiguration(d.Get("routing").([]interface{})),
 		},
 	}
@@ -349,6 +380,8 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, me
 
 func resourceVpnGatewayConnectionResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VpnConnectionsClient
+	linkConnectionsClient := meta.(*clients.Client).Network.VpnLinkConnectionsClient
+	keyVaultClient := meta.(*clients.Client).KeyVault.ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -396,7 +429,25 @@ func resourceVpnGatewayConnectionResourceRead(d *schema.ResourceData, meta inter
 			return fmt.Errorf(`setting "routing": %v`, err)
 		}
 
-		if err := d.Set("vpn_link", flattenVpnGatewayConnectionVpnSiteLinkConnections(prop.VpnLinkConnections)); err != nil {
+		// the connection status and traffic counters are only returned when listing the link connections
+		linkConnections := make(map[string]network.VpnSiteLinkConnection)
+		iterator, err := linkConnectionsClient.ListByVpnConnectionComplete(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+		if err != nil {
+			return fmt.Errorf("listing Link Connections for Vpn Gateway Connection Resource %q (Resource Group %q / VPN Gateway %q): %+v", id.Name, id.ResourceGroup, id.VpnGatewayName, err)
+		}
+		for iterator.NotDone() {
+			linkConnection := iterator.Value()
+			if linkConnection.Name != nil {
+				linkConnections[*linkConnection.Name] = linkConnection
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Link Connections for Vpn Gateway Connection Resource %q (Resource Group %q / VPN Gateway %q): %+v", id.Name, id.ResourceGroup, id.VpnGatewayName, err)
+			}
+		}
+
+		secretIds := vpnGatewayConnectionSharedKeySecretIds(ctx, keyVaultClient, prop.VpnLinkConnections, d.Get("vpn_link").([]interface{}))
+		if err := d.Set("vpn_link", flattenVpnGatewayConnectionVpnSiteLinkConnections(prop.VpnLinkConnections, linkConnections, secretIds)); err != nil {
 			return fmt.Errorf(`setting "vpn_link": %v`, err)
 		}
 	}
@@ -433,9 +484,9 @@ func resourceVpnGatewayConnectionResourceDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
-func expandVpnGatewayConnectionVpnSiteLinkConnections(input []interface{}) *[]network.VpnSiteLinkConnection {
+func expandVpnGatewayConnectionVpnSiteLinkConnections(ctx context.Context, keyVaultClient *keyvault.BaseClient, input []interface{}) (*[]network.VpnSiteLinkConnection, error) {
 	if len(input) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	result := make([]network.VpnSiteLinkConnection, 0)
@@ -457,17 +508,98 @@ func expandVpnGatewayConnectionVpnSiteLinkConnections(input []interface{}) *[]ne
 			},
 		}
 
-		if sharedKey := e["shared_key"]; sharedKey != "" {
-			sharedKey := sharedKey.(string)
-			v.VpnSiteLinkConnectionProperties.SharedKey = &sharedKey
+		sharedKey := e["shared_key"].(string)
+		secretId := e["shared_key_key_vault_secret_id"].(string)
+		if sharedKey != "" && secretId != "" {
+			return nil, fmt.Errorf("only one of `shared_key` and `shared_key_key_vault_secret_id` can be specified for the `vpn_link` %q", *v.Name)
+		}
+
+		if secretId != "" {
+			value, err := getVpnGatewayConnectionSharedKeyFromKeyVault(ctx, keyVaultClient, secretId)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving the shared key for the `vpn_link` %q: %+v", *v.Name, err)
+			}
+			sharedKey = value
+		}
+
+		if sharedKey != "" {
+			v.VpnSiteLinkConnectionProperties.SharedKey = utils.String(sharedKey)
 		}
 		result = append(result, v)
 	}
 
-	return &result
+	return &result, nil
 }
 
-func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteLinkConnection) interface{} {
+func getVpnGatewayConnectionSharedKeyFromKeyVault(ctx context.Context, client *keyvault.BaseClient, secretId string) (string, error) {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return "", fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Value == nil || *resp.Value == "" {
+		return "", fmt.Errorf("Secret %q (Key Vault %q) has no value", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	return *resp.Value, nil
+}
+
+// vpnGatewayConnectionSharedKeySecretIds returns the Key Vault Secret ID used for the shared key of each link connection
+// (keyed by name), which is only known from the config since the shared key is resolved when the link connection is
+// sent. When a versionless Secret has since been rotated the Secret ID is returned as empty, so that a diff is shown.
+func vpnGatewayConnectionSharedKeySecretIds(ctx context.Context, keyVaultClient *keyvault.BaseClient, input *[]network.VpnSiteLinkConnection, existing []interface{}) map[string]string {
+	sharedKeys := make(map[string]string)
+	if input != nil {
+		for _, e := range *input {
+			if e.Name != nil && e.VpnSiteLinkConnectionProperties != nil && e.SharedKey != nil {
+				sharedKeys[*e.Name] = *e.SharedKey
+			}
+		}
+	}
+
+	secretIds := make(map[string]string)
+	for _, raw := range existing {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		secretId := v["shared_key_key_vault_secret_id"].(string)
+		if secretId == "" {
+			continue
+		}
+		secretIds[name] = secretId
+
+		id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+		if err != nil || id.Version != "" {
+			continue
+		}
+
+		sharedKey, ok := sharedKeys[name]
+		if !ok {
+			continue
+		}
+
+		value, err := getVpnGatewayConnectionSharedKeyFromKeyVault(ctx, keyVaultClient, secretId)
+		if err != nil {
+			log.Printf("[WARN] Unable to determine whether the shared key for the `vpn_link` %q is up to date: %+v", name, err)
+			continue
+		}
+		if value != sharedKey {
+			log.Printf("[DEBUG] The Secret %q used for the shared key of the `vpn_link` %q has changed", secretId, name)
+			secretIds[name] = ""
+		}
+	}
+
+	return secretIds
+}
+
+func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteLinkConnection, linkConnections map[string]network.VpnSiteLinkConnection, secretIds map[string]string) interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -495,8 +627,10 @@ func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteL
 			bandwidth = int(*e.ConnectionBandwidth)
 		}
 
+		// the shared key isn't exposed when it's retrieved from Key Vault, even when the Secret has changed
 		sharedKey := ""
-		if e.SharedKey != nil {
+		secretId, fromKeyVault := secretIds[name]
+		if e.SharedKey != nil && !fromKeyVault {
 			sharedKey = *e.SharedKey
 		}
 
@@ -520,6 +654,20 @@ func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteL
 			useLocalAzureIpAddress = *e.UseLocalAzureIPAddress
 		}
 
+		connectionStatus := ""
+		ingressBytesTransferred := 0
+		egressBytesTransferred := 0
+		if linkConnection, ok := linkConnections[name]; ok && linkConnection.VpnSiteLinkConnectionProperties != nil {
+			props := linkConnection.VpnSiteLinkConnectionProperties
+			connectionStatus = string(props.ConnectionStatus)
+			if props.IngressBytesTransferred != nil {
+				ingressBytesTransferred = int(*props.IngressBytesTransferred)
+			}
+			if props.EgressBytesTransferred != nil {
+				egressBytesTransferred = int(*props.EgressBytesTransferred)
+			}
+		}
+
 		v := map[string]interface{}{
 			"name":                                  name,
 			"vpn_site_link_id":                      vpnSiteLinkId,
@@ -527,11 +675,15 @@ func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteL
 			"protocol":                              string(e.VpnConnectionProtocolType),
 			"bandwidth_mbps":                        bandwidth,
 			"shared_key":                            sharedKey,
+			"shared_key_key_vault_secret_id":        secretId,
 			"bgp_enabled":                           bgpEnabled,
 			"ipsec_policy":                          flattenVpnGatewayConnectionIpSecPolicies(e.IpsecPolicies),
 			"ratelimit_enabled":                     rateLimitEnabled,
 			"local_azure_ip_address_enabled":        useLocalAzureIpAddress,
 			"policy_based_traffic_selector_enabled": usePolicyBased,
+			"connection_status":                     connectionStatus,
+			"ingress_bytes_transferred":             ingressBytesTransferred,
+			"egress_bytes_transferred":              egressBytesTransferred,
 		}
 
 		output = append(output, v)
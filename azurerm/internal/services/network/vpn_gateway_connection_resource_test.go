@@ -98,6 +98,25 @@ func TestAccVpnGatewayConnection_customRouteTable(t *testing.T) {
 	})
 }
 
+func TestAccVpnGatewayConnection_sharedKeyFromKeyVault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection", "test")
+	r := VPNGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sharedKeyFromKeyVault(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_link.0.shared_key").HasValue(""),
+				check.That(data.ResourceName).Key("vpn_link.0.shared_key_key_vault_secret_id").Exists(),
+				check.That(data.ResourceName).Key("vpn_link.0.connection_status").Exists(),
+			),
+		},
+		// the secret ID is only known from the configuration
+		data.ImportStep("vpn_link.0.shared_key", "vpn_link.0.shared_key_key_vault_secret_id"),
+	})
+}
+
 func TestAccVpnGatewayConnection_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection", "test")
 	r := VPNGatewayConnectionResource{}
@@ -286,6 +305,54 @@ resource "azurerm_vpn_gateway_connection" "import" {
 `, r.basic(data))
 }
 
+func (r VPNGatewayConnectionResource) sharedKeyFromKeyVault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[2]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "vpn-shared-key"
+  value        = "Secret-%[2]s"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctest-VpnGwConn-%[3]d"
+  vpn_gateway_id     = azurerm_vpn_gateway.test.id
+  remote_vpn_site_id = azurerm_vpn_site.test.id
+  vpn_link {
+    name                           = "link1"
+    vpn_site_link_id               = azurerm_vpn_site.test.link[0].id
+    shared_key_key_vault_secret_id = azurerm_key_vault_secret.test.id
+  }
+  vpn_link {
+    name             = "link2"
+    vpn_site_link_id = azurerm_vpn_site.test.link[1].id
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
 func (VPNGatewayConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,115 @@
+package network
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVpnSitesConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVpnSitesConfigurationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			// generating the device configuration is a long running operation
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualWanID,
+			},
+
+			"vpn_site_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.VpnSiteID,
+				},
+			},
+
+			"output_blob_sas_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceVpnSitesConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnSitesConfigurationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	wanId, err := parse.VirtualWanID(d.Get("virtual_wan_id").(string))
+	if err != nil {
+		return err
+	}
+
+	sasUrl := d.Get("output_blob_sas_url").(string)
+	request := network.GetVpnSitesConfigurationRequest{
+		VpnSites:         utils.ExpandStringSlice(d.Get("vpn_site_ids").([]interface{})),
+		OutputBlobSasURL: utils.String(sasUrl),
+	}
+
+	future, err := client.Download(ctx, wanId.ResourceGroup, wanId.Name, request)
+	if err != nil {
+		return fmt.Errorf("downloading the VPN Sites Configuration for %s: %+v", *wanId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the VPN Sites Configuration for %s to be downloaded: %+v", *wanId, err)
+	}
+
+	// the configuration is written to the blob rather than returned, so we need to retrieve it from there - using the
+	// configured Sender (so that proxies, logging and retries apply) but without the Resource Manager authorization
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sasUrl, nil)
+	if err != nil {
+		return fmt.Errorf("building the request to retrieve the VPN Sites Configuration for %s: %+v", *wanId, err)
+	}
+	req.Header.Set("User-Agent", client.UserAgent)
+
+	resp, err := autorest.SendWithSender(client.Sender, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return fmt.Errorf("retrieving the VPN Sites Configuration for %s from the output blob: %+v", *wanId, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("retrieving the VPN Sites Configuration for %s from the output blob: unexpected status %d", *wanId, resp.StatusCode)
+	}
+
+	configuration, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading the VPN Sites Configuration for %s: %+v", *wanId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("virtual_wan_id", wanId.ID())
+	d.Set("configuration", string(configuration))
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VpnSitesConfigurationDataSource struct {
+}
+
+func TestAccDataSourceVpnSitesConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_vpn_sites_configuration", "test")
+	r := VpnSitesConfigurationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("configuration").Exists(),
+			),
+		},
+	})
+}
+
+func (VpnSitesConfigurationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctest-VpnGwConn-%[2]d"
+  vpn_gateway_id     = azurerm_vpn_gateway.test.id
+  remote_vpn_site_id = azurerm_vpn_site.test.id
+  vpn_link {
+    name             = "link1"
+    vpn_site_link_id = azurerm_vpn_site.test.link[0].id
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vpnconfig"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2020-01-01"
+  expiry = "2048-01-01"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = false
+  }
+}
+
+data "azurerm_vpn_sites_configuration" "test" {
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  vpn_site_ids        = [azurerm_vpn_gateway_connection.test.remote_vpn_site_id]
+  output_blob_sas_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/vpnconfig.json${data.azurerm_storage_account_blob_container_sas.test.sas}"
+}
+`, VPNGatewayConnectionResource{}.template(data), data.RandomInteger, data.RandomString)
+}
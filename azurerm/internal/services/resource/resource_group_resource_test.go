@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -84,6 +85,29 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_drift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []resource.TestStep{
+		data.DriftStep(acceptance.DriftStepData{
+			Config:          testResource.withTagsConfig,
+			TestResource:    testResource,
+			Mutate:          testResource.removeTags,
+			ExpectedChanges: []string{"tags"},
+		}),
+	})
+}
+
+func TestAccResourceGroup_importRoundTrip(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []resource.TestStep{
+		data.ImportRoundTripStep(testResource.withTagsConfig),
+	})
+}
+
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resourceGroup := state.Attributes["name"]
 
@@ -112,6 +136,19 @@ func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Clien
 	return utils.Bool(resp.Properties != nil), nil
 }
 
+func (t ResourceGroupResource) removeTags(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+	name := state.Attributes["name"]
+
+	parameters := resources.GroupPatchable{
+		Tags: map[string]*string{},
+	}
+	if _, err := client.Resource.GroupsClient.Update(ctx, name, parameters); err != nil {
+		return fmt.Errorf("removing Tags from Resource Group %q: %+v", name, err)
+	}
+
+	return nil
+}
+
 func (t ResourceGroupResource) basicConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
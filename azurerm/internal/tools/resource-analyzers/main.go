@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tools/resource-analyzers/passes"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(passes.Analyzers...)
+}
@@ -0,0 +1,12 @@
+package passes
+
+import (
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzers is the list of all of the Resource Analyzers
+var Analyzers = []*analysis.Analyzer{
+	CreateRequiresImportAnalyzer,
+	ReadNotFoundAnalyzer,
+	ReadSetAnalyzer,
+}
@@ -0,0 +1,45 @@
+package passes
+
+import (
+	"golang.org/x/tools/go/analysis"
+)
+
+const createRequiresImportName = "resourcecreaterequiresimport"
+
+var CreateRequiresImportAnalyzer = &analysis.Analyzer{
+	Name: createRequiresImportName,
+	Doc: `checks that the Create function of a Resource checks for an existing resource
+
+Prior to creating a resource the Create function must check whether the resource already
+exists, and if so return 'tf.ImportAsExistsError' (or 'metadata.ResourceRequiresImport' for
+Typed Resources) so that existing resources are imported rather than silently adopted.
+
+This can be ignored using a '//lintignore:resourcecreaterequiresimport' comment.`,
+	Run: runCreateRequiresImport,
+}
+
+func runCreateRequiresImport(pass *analysis.Pass) (interface{}, error) {
+	inspector := newPkgInspector(pass)
+
+	for _, resource := range inspector.untypedResources() {
+		if resource.create == nil || inspector.isIgnored(resource.literal, createRequiresImportName) || inspector.isIgnored(resource.createExpr, createRequiresImportName) {
+			continue
+		}
+
+		if !inspector.callsFunction(resource.create, providerTfPackage, "ImportAsExistsError") {
+			pass.Reportf(resource.createExpr.Pos(), "the Create function %s must check for an existing resource and return `tf.ImportAsExistsError`", functionName(resource.create))
+		}
+	}
+
+	for _, resource := range inspector.typedResources() {
+		if resource.create == nil || inspector.isIgnored(resource.create, createRequiresImportName) {
+			continue
+		}
+
+		if !inspector.callsFunction(resourceFuncBody(resource.create), providerSdkPackage, "ResourceRequiresImport") {
+			pass.Reportf(resource.create.Pos(), "the Create function for %s must check for an existing resource and return `metadata.ResourceRequiresImport`", resource.name)
+		}
+	}
+
+	return nil, nil
+}
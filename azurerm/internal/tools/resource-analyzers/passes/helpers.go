@@ -0,0 +1,459 @@
+package passes
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	pluginSdkSchemaPackage = "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	providerSdkPackage     = "azurerm/internal/sdk"
+	providerTagsPackage    = "azurerm/internal/tags"
+	providerTfPackage      = "azurerm/helpers/tf"
+	providerUtilsPackage   = "azurerm/utils"
+)
+
+// untypedResource is a `schema.Resource` literal with a Read function, which is either
+// a Data Source or (when there's a Create function) a Resource
+type untypedResource struct {
+	literal    *ast.CompositeLit
+	schemaExpr ast.Expr
+	schema     map[string]*ast.BasicLit
+
+	// createExpr and readExpr are the expressions referencing the Create and Read functions, which
+	// the functions themselves (create and read) are resolved from
+	createExpr ast.Expr
+	create     ast.Node
+	readExpr   ast.Expr
+	read       ast.Node
+}
+
+// typedResource is a type implementing the `sdk.Resource` or `sdk.DataSource` interfaces
+type typedResource struct {
+	name        string
+	arguments   map[string]*ast.BasicLit
+	attributes  map[string]*ast.BasicLit
+	modelObject types.Type
+	create      *ast.FuncDecl
+	read        *ast.FuncDecl
+}
+
+// pkgInspector provides the functionality shared between the analyzers
+type pkgInspector struct {
+	pass      *analysis.Pass
+	functions map[*types.Func]*ast.FuncDecl
+}
+
+func newPkgInspector(pass *analysis.Pass) *pkgInspector {
+	functions := make(map[*types.Func]*ast.FuncDecl)
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if obj, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func); ok {
+				functions[obj] = funcDecl
+			}
+		}
+	}
+
+	return &pkgInspector{
+		pass:      pass,
+		functions: functions,
+	}
+}
+
+// untypedResources returns each of the `schema.Resource` literals within the package which define a Read function
+func (p *pkgInspector) untypedResources() []untypedResource {
+	output := make([]untypedResource, 0)
+
+	for _, file := range p.pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			literal, ok := n.(*ast.CompositeLit)
+			if !ok || !isNamedType(p.pass.TypesInfo.TypeOf(literal), pluginSdkSchemaPackage, "Resource") {
+				return true
+			}
+
+			resource := untypedResource{
+				literal: literal,
+			}
+			for _, elt := range literal.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				switch key.Name {
+				case "Schema":
+					resource.schemaExpr = kv.Value
+					resource.schema = stringKeysOfMapLiteral(kv.Value)
+				case "Create":
+					resource.createExpr = kv.Value
+					resource.create = p.resolveFunction(kv.Value)
+				case "Read":
+					resource.readExpr = kv.Value
+					resource.read = p.resolveFunction(kv.Value)
+				}
+			}
+
+			if resource.read != nil {
+				output = append(output, resource)
+			}
+			return true
+		})
+	}
+
+	return output
+}
+
+// typedResources returns each of the types within the package which implement a Read method returning an `sdk.ResourceFunc`
+func (p *pkgInspector) typedResources() []typedResource {
+	resources := make(map[string]*typedResource)
+	names := make([]string, 0)
+
+	for _, decl := range p.functions {
+		if decl.Recv == nil || len(decl.Recv.List) != 1 {
+			continue
+		}
+
+		receiver := p.pass.TypesInfo.TypeOf(decl.Recv.List[0].Type)
+		if pointer, ok := receiver.(*types.Pointer); ok {
+			receiver = pointer.Elem()
+		}
+		named, ok := receiver.(*types.Named)
+		if !ok {
+			continue
+		}
+
+		name := named.Obj().Name()
+		resource, ok := resources[name]
+		if !ok {
+			resource = &typedResource{
+				name: name,
+			}
+			resources[name] = resource
+			names = append(names, name)
+		}
+
+		switch decl.Name.Name {
+		case "Arguments":
+			resource.arguments = stringKeysOfMapLiteral(returnedExpression(decl))
+		case "Attributes":
+			resource.attributes = stringKeysOfMapLiteral(returnedExpression(decl))
+		case "ModelObject":
+			if expr := returnedExpression(decl); expr != nil {
+				resource.modelObject = p.pass.TypesInfo.TypeOf(expr)
+			}
+		case "Create":
+			resource.create = decl
+		case "Read":
+			if decl.Type.Results != nil && len(decl.Type.Results.List) == 1 && isResourceFunc(p.pass.TypesInfo.TypeOf(decl.Type.Results.List[0].Type)) {
+				resource.read = decl
+			}
+		}
+	}
+
+	sort.Strings(names)
+	output := make([]typedResource, 0)
+	for _, name := range names {
+		if resource := resources[name]; resource.read != nil {
+			output = append(output, *resource)
+		}
+	}
+	return output
+}
+
+// resolveFunction returns the function literal or declaration within this package for the specified expression
+func (p *pkgInspector) resolveFunction(expr ast.Expr) ast.Node {
+	switch v := expr.(type) {
+	case *ast.FuncLit:
+		return v
+	case *ast.Ident:
+		return p.declarationFor(v)
+	case *ast.SelectorExpr:
+		return p.declarationFor(v.Sel)
+	}
+
+	return nil
+}
+
+func (p *pkgInspector) declarationFor(ident *ast.Ident) ast.Node {
+	obj, ok := p.pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	if decl, ok := p.functions[obj]; ok {
+		return decl
+	}
+	return nil
+}
+
+// inspectReachable calls visit for each node within the root node - and within any functions in this package
+// which are called from it, so that helper functions (e.g. for flattening) are taken into account
+func (p *pkgInspector) inspectReachable(root ast.Node, visit func(n ast.Node)) {
+	seen := make(map[ast.Node]struct{})
+
+	var inspect func(node ast.Node)
+	inspect = func(node ast.Node) {
+		if _, ok := seen[node]; ok {
+			return
+		}
+		seen[node] = struct{}{}
+
+		ast.Inspect(node, func(n ast.Node) bool {
+			if n == nil {
+				return false
+			}
+			visit(n)
+
+			if call, ok := n.(*ast.CallExpr); ok {
+				if decl := p.resolveFunction(call.Fun); decl != nil {
+					inspect(decl)
+				}
+			}
+			return true
+		})
+	}
+
+	inspect(root)
+}
+
+// calledFunction returns the function called by the call expression, if it can be determined
+func (p *pkgInspector) calledFunction(call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch v := call.Fun.(type) {
+	case *ast.Ident:
+		ident = v
+	case *ast.SelectorExpr:
+		ident = v.Sel
+	default:
+		return nil
+	}
+
+	if obj, ok := p.pass.TypesInfo.Uses[ident].(*types.Func); ok {
+		return obj
+	}
+	return nil
+}
+
+// callsFunction returns whether the root node (or any function reachable from it) calls the function
+// with the specified name within a package whose path ends with the specified suffix
+func (p *pkgInspector) callsFunction(root ast.Node, packageSuffix string, name string) bool {
+	found := false
+	p.inspectReachable(root, func(n ast.Node) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return
+		}
+
+		if obj := p.calledFunction(call); obj != nil && obj.Name() == name && obj.Pkg() != nil && strings.HasSuffix(obj.Pkg().Path(), packageSuffix) {
+			found = true
+		}
+	})
+	return found
+}
+
+// stringConstant returns the value of the expression if it's a constant string
+func (p *pkgInspector) stringConstant(expr ast.Expr) (string, bool) {
+	tv, ok := p.pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// isIgnored returns whether the node has been annotated with a `//lintignore:NAME` comment, either on the same line or the line above
+func (p *pkgInspector) isIgnored(node ast.Node, analyzerName string) bool {
+	line := p.pass.Fset.Position(node.Pos()).Line
+	filename := p.pass.Fset.Position(node.Pos()).Filename
+
+	for _, file := range p.pass.Files {
+		if p.pass.Fset.Position(file.Pos()).Filename != filename {
+			continue
+		}
+
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				commentLine := p.pass.Fset.Position(comment.Pos()).Line
+				if commentLine != line && commentLine != line-1 {
+					continue
+				}
+
+				text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+				if text == "lintignore:"+analyzerName {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// functionName returns a human-readable name for the function
+func functionName(node ast.Node) string {
+	if decl, ok := node.(*ast.FuncDecl); ok {
+		return decl.Name.Name
+	}
+
+	return "(function literal)"
+}
+
+func isNamedType(t types.Type, packagePath string, name string) bool {
+	if t == nil {
+		return false
+	}
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Name() == name && strings.HasSuffix(named.Obj().Pkg().Path(), packagePath)
+}
+
+func isResourceFunc(t types.Type) bool {
+	return isNamedType(t, providerSdkPackage, "ResourceFunc")
+}
+
+// returnedExpression returns the expression returned by a function which only contains a single return statement
+func returnedExpression(decl *ast.FuncDecl) ast.Expr {
+	if decl.Body == nil || len(decl.Body.List) == 0 {
+		return nil
+	}
+
+	ret, ok := decl.Body.List[len(decl.Body.List)-1].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil
+	}
+
+	return ret.Results[0]
+}
+
+// resourceFuncBody returns the `Func` defined within the `sdk.ResourceFunc` returned by the method
+func resourceFuncBody(decl *ast.FuncDecl) ast.Node {
+	literal, ok := returnedExpression(decl).(*ast.CompositeLit)
+	if !ok {
+		return decl
+	}
+
+	for _, elt := range literal.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Func" {
+			return kv.Value
+		}
+	}
+
+	return decl
+}
+
+// stringKeysOfMapLiteral returns the string keys (and their positions) of a map literal
+func stringKeysOfMapLiteral(expr ast.Expr) map[string]*ast.BasicLit {
+	literal, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	output := make(map[string]*ast.BasicLit)
+	for _, elt := range literal.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		output[strings.Trim(key.Value, "`\"")] = key
+	}
+	return output
+}
+
+// recvType returns the receiver type of a method, or nil for functions
+func recvType(obj *types.Func) types.Type {
+	signature, ok := obj.Type().(*types.Signature)
+	if !ok || signature.Recv() == nil {
+		return nil
+	}
+
+	return signature.Recv().Type()
+}
+
+func hasPackageSuffix(obj *types.Func, suffix string) bool {
+	return obj.Pkg() != nil && strings.HasSuffix(obj.Pkg().Path(), suffix)
+}
+
+// tfschemaTags returns the values of the `tfschema` tags for the fields within the struct
+func tfschemaTags(t types.Type) map[string]struct{} {
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	output := make(map[string]struct{})
+	structType, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return output
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		if tag, ok := reflect.StructTag(structType.Tag(i)).Lookup("tfschema"); ok {
+			output[strings.Split(tag, ",")[0]] = struct{}{}
+		}
+	}
+	return output
+}
+
+// isRequiredOnly returns whether the Schema for the key within the map literal is Required (and not Computed)
+func isRequiredOnly(mapLiteral ast.Expr, key *ast.BasicLit) bool {
+	literal, ok := mapLiteral.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	for _, elt := range literal.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok || kv.Key != key {
+			continue
+		}
+
+		value, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+
+		fields := make(map[string]bool)
+		for _, field := range value.Elts {
+			fieldKv, ok := field.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			name, nameOk := fieldKv.Key.(*ast.Ident)
+			val, valOk := fieldKv.Value.(*ast.Ident)
+			if nameOk && valOk {
+				fields[name.Name] = val.Name == "true"
+			}
+		}
+		return fields["Required"] && !fields["Computed"]
+	}
+
+	return false
+}
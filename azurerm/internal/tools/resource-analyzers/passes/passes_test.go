@@ -0,0 +1,29 @@
+package passes
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+const servicesPackage = "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services"
+
+// allAnalyzers runs each of the Analyzers against the same package, so that a single set of Resources
+// (both complete and incomplete) can be used to test all of them
+var allAnalyzers = &analysis.Analyzer{
+	Name: "resourceanalyzers",
+	Doc:  "runs each of the Resource Analyzers",
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		for _, analyzer := range Analyzers {
+			if _, err := analyzer.Run(pass); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	},
+}
+
+func TestAnalyzers(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), allAnalyzers, servicesPackage+"/example")
+}
@@ -0,0 +1,73 @@
+package passes
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const readNotFoundName = "resourcereadnotfound"
+
+var ReadNotFoundAnalyzer = &analysis.Analyzer{
+	Name: readNotFoundName,
+	Doc: `checks that the Read function of a Resource removes it from the state when it's not found
+
+When the resource no longer exists (e.g. it's been deleted outside of Terraform) the Read
+function must check for this using 'utils.ResponseWasNotFound' and then remove it from the
+state using 'd.SetId("")' (or 'metadata.MarkAsGone()' for Typed Resources), rather than
+returning an error. Data Sources are not checked, since these should return an error.
+
+This can be ignored using a '//lintignore:resourcereadnotfound' comment.`,
+	Run: runReadNotFound,
+}
+
+func runReadNotFound(pass *analysis.Pass) (interface{}, error) {
+	inspector := newPkgInspector(pass)
+
+	for _, resource := range inspector.untypedResources() {
+		// Data Sources have no Create function
+		if resource.createExpr == nil || inspector.isIgnored(resource.literal, readNotFoundName) || inspector.isIgnored(resource.readExpr, readNotFoundName) {
+			continue
+		}
+
+		checksNotFound := inspector.callsFunction(resource.read, providerUtilsPackage, "ResponseWasNotFound")
+		if !checksNotFound || !inspector.clearsId(resource.read) {
+			pass.Reportf(resource.readExpr.Pos(), "the Read function %s must remove the resource from the state using `d.SetId(\"\")` when `utils.ResponseWasNotFound` rather than returning an error", functionName(resource.read))
+		}
+	}
+
+	for _, resource := range inspector.typedResources() {
+		if resource.create == nil || inspector.isIgnored(resource.read, readNotFoundName) {
+			continue
+		}
+
+		body := resourceFuncBody(resource.read)
+		checksNotFound := inspector.callsFunction(body, providerUtilsPackage, "ResponseWasNotFound")
+		if !checksNotFound || !inspector.callsFunction(body, providerSdkPackage, "MarkAsGone") {
+			pass.Reportf(resource.read.Pos(), "the Read function for %s must call `metadata.MarkAsGone()` when `utils.ResponseWasNotFound` rather than returning an error", resource.name)
+		}
+	}
+
+	return nil, nil
+}
+
+// clearsId returns whether the root node (or any function reachable from it) calls `d.SetId("")`
+func (p *pkgInspector) clearsId(root ast.Node) bool {
+	found := false
+	p.inspectReachable(root, func(n ast.Node) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found || len(call.Args) != 1 {
+			return
+		}
+
+		obj := p.calledFunction(call)
+		if obj == nil || obj.Name() != "SetId" || !isNamedType(recvType(obj), pluginSdkSchemaPackage, "ResourceData") {
+			return
+		}
+
+		if value, ok := p.stringConstant(call.Args[0]); ok && value == "" {
+			found = true
+		}
+	})
+	return found
+}
@@ -0,0 +1,114 @@
+package passes
+
+import (
+	"go/ast"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const readSetName = "resourcereadset"
+
+var ReadSetAnalyzer = &analysis.Analyzer{
+	Name: readSetName,
+	Doc: `checks that each field in the Schema is set into the state within the Read function
+
+For (untyped) Resources and Data Sources each key within the Schema must be set using
+'d.Set' (or 'tags.FlattenAndSet') in the Read function, or a function in the same package
+which it calls. For Typed Resources each key within the Arguments and Attributes must
+be mapped to a field in the Model Object using a 'tfschema' tag. The Required arguments
+of Data Sources are not checked, since these are used to look up the Data Source.
+
+Fields which intentionally aren't set (e.g. write-only fields) can be ignored using a
+'//lintignore:resourcereadset' comment.`,
+	Run: runReadSet,
+}
+
+func runReadSet(pass *analysis.Pass) (interface{}, error) {
+	inspector := newPkgInspector(pass)
+
+	for _, resource := range inspector.untypedResources() {
+		if resource.schema == nil || inspector.isIgnored(resource.literal, readSetName) {
+			continue
+		}
+
+		setKeys := inspector.keysSetWithinReadFunction(resource.read)
+		for _, key := range sortedKeys(resource.schema) {
+			if _, ok := setKeys[key]; ok {
+				continue
+			}
+
+			node := resource.schema[key]
+			if inspector.isIgnored(node, readSetName) {
+				continue
+			}
+
+			// the Required arguments of a Data Source are used to look it up, so needn't be set
+			if resource.createExpr == nil && isRequiredOnly(resource.schemaExpr, node) {
+				continue
+			}
+			pass.Reportf(node.Pos(), "%q is defined in the Schema but is never set in the Read function %s", key, functionName(resource.read))
+		}
+	}
+
+	for _, resource := range inspector.typedResources() {
+		if resource.modelObject == nil || inspector.isIgnored(resource.read, readSetName) {
+			continue
+		}
+
+		modelFields := tfschemaTags(resource.modelObject)
+		for _, fields := range []map[string]*ast.BasicLit{resource.arguments, resource.attributes} {
+			for _, key := range sortedKeys(fields) {
+				if _, ok := modelFields[key]; ok {
+					continue
+				}
+
+				node := fields[key]
+				if inspector.isIgnored(node, readSetName) {
+					continue
+				}
+				pass.Reportf(node.Pos(), "%q is defined in the Schema for %s but has no matching `tfschema` tag in the Model Object", key, resource.name)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// keysSetWithinReadFunction returns the keys which are set into the state by the Read function
+func (p *pkgInspector) keysSetWithinReadFunction(read ast.Node) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	p.inspectReachable(read, func(n ast.Node) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+
+		obj := p.calledFunction(call)
+		if obj == nil || obj.Pkg() == nil {
+			return
+		}
+
+		switch {
+		case obj.Name() == "Set" && isNamedType(recvType(obj), pluginSdkSchemaPackage, "ResourceData") && len(call.Args) == 2:
+			if key, ok := p.stringConstant(call.Args[0]); ok {
+				output[key] = struct{}{}
+			}
+
+		case obj.Name() == "FlattenAndSet" && hasPackageSuffix(obj, providerTagsPackage):
+			output["tags"] = struct{}{}
+		}
+	})
+
+	return output
+}
+
+func sortedKeys(input map[string]*ast.BasicLit) []string {
+	output := make([]string, 0)
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,29 @@
+package schema
+
+type ValueType int
+
+const TypeString ValueType = 1
+
+type Schema struct {
+	Type     ValueType
+	Required bool
+	Optional bool
+	Computed bool
+}
+
+type ResourceData struct{}
+
+func (d *ResourceData) Id() string                          { return "" }
+func (d *ResourceData) Get(key string) interface{}          { return nil }
+func (d *ResourceData) Set(key string, v interface{}) error { return nil }
+func (d *ResourceData) SetId(id string)                     {}
+
+type CreateFunc func(*ResourceData, interface{}) error
+type ReadFunc func(*ResourceData, interface{}) error
+
+type Resource struct {
+	Schema map[string]*Schema
+	Create CreateFunc
+	Read   ReadFunc
+	Delete CreateFunc
+}
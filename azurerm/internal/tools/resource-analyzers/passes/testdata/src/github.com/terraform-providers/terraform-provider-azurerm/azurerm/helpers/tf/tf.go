@@ -0,0 +1,7 @@
+package tf
+
+import "fmt"
+
+func ImportAsExistsError(resourceName, id string) error {
+	return fmt.Errorf("%s %s needs to be imported", resourceName, id)
+}
@@ -0,0 +1,22 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type ResourceMetaData struct {
+	ResourceData *schema.ResourceData
+}
+
+func (rmd ResourceMetaData) Encode(input interface{}) error { return nil }
+func (rmd ResourceMetaData) MarkAsGone() error              { return nil }
+func (rmd ResourceMetaData) ResourceRequiresImport(resourceName string, id string) error {
+	return fmt.Errorf("%s %s needs to be imported", resourceName, id)
+}
+
+type ResourceFunc struct {
+	Func func(ctx context.Context, metadata ResourceMetaData) error
+}
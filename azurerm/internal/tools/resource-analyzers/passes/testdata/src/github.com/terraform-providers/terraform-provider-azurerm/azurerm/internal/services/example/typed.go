@@ -0,0 +1,118 @@
+package example
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type CompleteModel struct {
+	Name     string `tfschema:"name"`
+	Endpoint string `tfschema:"endpoint"`
+}
+
+type CompleteResource struct{}
+
+func (r CompleteResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (r CompleteResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CompleteResource) ModelObject() interface{} {
+	return CompleteModel{}
+}
+
+func (r CompleteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resp, err := get("id")
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return err
+			}
+			if !utils.ResponseWasNotFound(resp) {
+				return metadata.ResourceRequiresImport("azurerm_complete", "id")
+			}
+			return nil
+		},
+	}
+}
+
+func (r CompleteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resp, err := get(metadata.ResourceData.Id())
+			if err != nil {
+				if utils.ResponseWasNotFound(resp) {
+					return metadata.MarkAsGone()
+				}
+				return err
+			}
+
+			return metadata.Encode(&CompleteModel{})
+		},
+	}
+}
+
+type IncompleteModel struct {
+	Name string `tfschema:"name"`
+}
+
+type IncompleteResource struct{}
+
+func (r IncompleteResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"sku_name": { // want `"sku_name" is defined in the Schema for IncompleteResource but has no matching .tfschema. tag in the Model Object`
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (r IncompleteResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (r IncompleteResource) ModelObject() interface{} {
+	return IncompleteModel{}
+}
+
+func (r IncompleteResource) Create() sdk.ResourceFunc { // want "the Create function for IncompleteResource must check for an existing resource and return `metadata.ResourceRequiresImport`"
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r IncompleteResource) Read() sdk.ResourceFunc { // want "the Read function for IncompleteResource must call `metadata.MarkAsGone\\(\\)` when `utils.ResponseWasNotFound` rather than returning an error"
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if _, err := get(metadata.ResourceData.Id()); err != nil {
+				return fmt.Errorf("retrieving: %+v", err)
+			}
+
+			return metadata.Encode(&IncompleteModel{})
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package example
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func get(id string) (*http.Response, error) {
+	return nil, nil
+}
+
+func resourceComplete() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCompleteCreate,
+		Read:   resourceCompleteRead,
+		Delete: resourceCompleteCreate,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			//lintignore:resourcereadset
+			"password": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceCompleteCreate(d *schema.ResourceData, meta interface{}) error {
+	resp, err := get(d.Get("name").(string))
+	if err != nil && !utils.ResponseWasNotFound(resp) {
+		return err
+	}
+	if !utils.ResponseWasNotFound(resp) {
+		return tf.ImportAsExistsError("azurerm_complete", "id")
+	}
+
+	return resourceCompleteRead(d, meta)
+}
+
+const locationKey = "location"
+
+func resourceCompleteRead(d *schema.ResourceData, meta interface{}) error {
+	resp, err := get(d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", "example")
+	d.Set(locationKey, "westeurope")
+	return flattenTags(d)
+}
+
+func flattenTags(d *schema.ResourceData) error {
+	return tags.FlattenAndSet(d, nil)
+}
+
+func resourceIncomplete() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIncompleteCreate, // want "the Create function resourceIncompleteCreate must check for an existing resource and return `tf.ImportAsExistsError`"
+		Read:   resourceIncompleteRead,   // want "the Read function resourceIncompleteRead must remove the resource from the state using `d.SetId\\(\"\"\\)` when `utils.ResponseWasNotFound` rather than returning an error"
+		Delete: resourceIncompleteCreate,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"sku_name": { // want `"sku_name" is defined in the Schema but is never set in the Read function resourceIncompleteRead`
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceIncompleteCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceIncompleteRead(d, meta)
+}
+
+func resourceIncompleteRead(d *schema.ResourceData, meta interface{}) error {
+	if _, err := get(d.Id()); err != nil {
+		return fmt.Errorf("retrieving: %+v", err)
+	}
+
+	d.Set("name", "example")
+	return nil
+}
+
+func dataSourceExample() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			if _, err := get(d.Get("name").(string)); err != nil {
+				return fmt.Errorf("retrieving: %+v", err)
+			}
+
+			d.SetId("id")
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": { // want `"location" is defined in the Schema but is never set in the Read function \(function literal\)`
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
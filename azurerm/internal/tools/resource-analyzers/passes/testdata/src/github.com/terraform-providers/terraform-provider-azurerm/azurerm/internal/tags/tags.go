@@ -0,0 +1,7 @@
+package tags
+
+import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+func FlattenAndSet(d *schema.ResourceData, tagMap map[string]*string) error {
+	return d.Set("tags", tagMap)
+}
@@ -0,0 +1,7 @@
+package utils
+
+import "net/http"
+
+func ResponseWasNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
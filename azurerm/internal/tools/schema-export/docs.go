@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// e.g. "* `name` - (Required) The name of the Resource Group." - only bullets at the start of the line are
+	// matched, so that nested bullets (for example those listing the possible values of a field) are ignored
+	documentedFieldRegex = regexp.MustCompile("^\\* `([a-zA-Z0-9_]+)`")
+
+	// e.g. "A `network_acls` block supports the following:" or "The `rule` block exports the following:" - which
+	// is where the top-level fields end
+	documentedBlockRegex = regexp.MustCompile("^(?:[^*`][^`]*)?`[a-zA-Z0-9_]+`.*(block|support|export|contain).*:$")
+)
+
+type websiteDocumentation struct {
+	arguments  map[string]struct{}
+	attributes map[string]struct{}
+}
+
+// checkWebsiteDocumentation returns the differences between the top-level arguments and attributes
+// listed in the website documentation and those defined in the Schema
+func checkWebsiteDocumentation(websitePath string, document resourceDocument) ([]string, error) {
+	directory := "r"
+	if document.Type == documentTypeDataSource {
+		directory = "d"
+	}
+	fileName := filepath.Join(websitePath, directory, fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(document.Name, "azurerm_")))
+
+	contents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{fmt.Sprintf("no documentation exists at %q", fileName)}, nil
+		}
+		return nil, err
+	}
+
+	docs := parseWebsiteDocumentation(string(contents))
+	return compareWebsiteDocumentation(docs, document.Type, document.Schema), nil
+}
+
+func parseWebsiteDocumentation(contents string) websiteDocumentation {
+	output := websiteDocumentation{
+		arguments:  make(map[string]struct{}),
+		attributes: make(map[string]struct{}),
+	}
+
+	var current map[string]struct{}
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+
+		if strings.HasPrefix(line, "## ") {
+			switch {
+			case strings.HasPrefix(line, "## Argument"):
+				current = output.arguments
+			case strings.HasPrefix(line, "## Attribute"):
+				current = output.attributes
+			default:
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		// only the top-level fields are compared, so we're done with this section
+		if documentedBlockRegex.MatchString(line) {
+			current = nil
+			continue
+		}
+
+		if match := documentedFieldRegex.FindStringSubmatch(line); len(match) == 2 {
+			current[match[1]] = struct{}{}
+		}
+	}
+
+	return output
+}
+
+func compareWebsiteDocumentation(docs websiteDocumentation, documentType string, fields map[string]schemaDocument) []string {
+	output := make([]string, 0)
+
+	keys := make([]string, 0)
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field := fields[key]
+		_, isDocumentedArgument := docs.arguments[key]
+		_, isDocumentedAttribute := docs.attributes[key]
+
+		// Optional fields within Data Sources (such as `tags`) are also returned, so are commonly documented
+		// as attributes - as such either is fine
+		if documentType == documentTypeDataSource && field.Optional && (isDocumentedArgument || isDocumentedAttribute) {
+			continue
+		}
+
+		if field.isArgument() {
+			// fields pending removal are commonly removed from the documentation in advance
+			if !isDocumentedArgument && field.Deprecated == "" {
+				output = append(output, fmt.Sprintf("the argument %q is not documented in the Arguments Reference", key))
+			}
+			continue
+		}
+
+		if isDocumentedArgument {
+			output = append(output, fmt.Sprintf("the attribute %q is documented in the Arguments Reference but isn't an argument", key))
+			continue
+		}
+		if !isDocumentedAttribute && field.Deprecated == "" {
+			output = append(output, fmt.Sprintf("the attribute %q is not documented in the Attributes Reference", key))
+		}
+	}
+
+	for _, documented := range []map[string]struct{}{docs.arguments, docs.attributes} {
+		documentedKeys := make([]string, 0)
+		for key := range documented {
+			documentedKeys = append(documentedKeys, key)
+		}
+		sort.Strings(documentedKeys)
+
+		for _, key := range documentedKeys {
+			if _, exists := fields[key]; !exists && key != "id" {
+				output = append(output, fmt.Sprintf("the field %q is documented but doesn't exist in the Schema", key))
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+const (
+	documentTypeDataSource = "data-source"
+	documentTypeResource   = "resource"
+)
+
+type resourceDocument struct {
+	Name               string                    `json:"name"`
+	Type               string                    `json:"type"`
+	Service            string                    `json:"service"`
+	WebsiteCategories  []string                  `json:"website_categories"`
+	Typed              bool                      `json:"typed"`
+	DeprecationMessage string                    `json:"deprecation_message,omitempty"`
+	ExampleID          string                    `json:"example_id,omitempty"`
+	Timeouts           *timeoutsDocument         `json:"timeouts,omitempty"`
+	Schema             map[string]schemaDocument `json:"schema"`
+}
+
+type timeoutsDocument struct {
+	Create string `json:"create,omitempty"`
+	Read   string `json:"read,omitempty"`
+	Update string `json:"update,omitempty"`
+	Delete string `json:"delete,omitempty"`
+}
+
+type schemaDocument struct {
+	Type          string                    `json:"type"`
+	Required      bool                      `json:"required,omitempty"`
+	Optional      bool                      `json:"optional,omitempty"`
+	Computed      bool                      `json:"computed,omitempty"`
+	ForceNew      bool                      `json:"force_new,omitempty"`
+	Sensitive     bool                      `json:"sensitive,omitempty"`
+	Deprecated    string                    `json:"deprecated,omitempty"`
+	Default       interface{}               `json:"default,omitempty"`
+	MinItems      int                       `json:"min_items,omitempty"`
+	MaxItems      int                       `json:"max_items,omitempty"`
+	ConflictsWith []string                  `json:"conflicts_with,omitempty"`
+	ElemType      string                    `json:"elem_type,omitempty"`
+	Block         map[string]schemaDocument `json:"block,omitempty"`
+}
+
+// isArgument returns whether this field can be specified by users
+func (s schemaDocument) isArgument() bool {
+	return s.Required || s.Optional
+}
+
+type serviceInfo struct {
+	name              string
+	websiteCategories []string
+	resourceIds       map[string]string
+}
+
+// buildDocuments returns a document for each Data Source and Resource supported by the Provider, sorted by type and name
+func buildDocuments(rootPath string) ([]resourceDocument, error) {
+	documents := make([]resourceDocument, 0)
+
+	for _, service := range provider.SupportedTypedServices() {
+		info, err := buildServiceInfo(rootPath, service, service.Name(), service.WebsiteCategories())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			dataSource, err := wrapper.DataSource()
+			if err != nil {
+				return nil, fmt.Errorf("creating Wrapper for Data Source %q: %+v", ds.ResourceType(), err)
+			}
+			documents = append(documents, buildDocument(ds.ResourceType(), documentTypeDataSource, true, info, dataSource))
+		}
+
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("creating Wrapper for Resource %q: %+v", r.ResourceType(), err)
+			}
+			documents = append(documents, buildDocument(r.ResourceType(), documentTypeResource, true, info, resource))
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		info, err := buildServiceInfo(rootPath, service, service.Name(), service.WebsiteCategories())
+		if err != nil {
+			return nil, err
+		}
+
+		for name, dataSource := range service.SupportedDataSources() {
+			documents = append(documents, buildDocument(name, documentTypeDataSource, false, info, dataSource))
+		}
+
+		for name, resource := range service.SupportedResources() {
+			documents = append(documents, buildDocument(name, documentTypeResource, false, info, resource))
+		}
+	}
+
+	sort.Slice(documents, func(i, j int) bool {
+		if documents[i].Type != documents[j].Type {
+			return documents[i].Type < documents[j].Type
+		}
+		return documents[i].Name < documents[j].Name
+	})
+	return documents, nil
+}
+
+func buildServiceInfo(rootPath string, registration interface{}, name string, websiteCategories []string) (*serviceInfo, error) {
+	packageSegments := strings.Split(reflect.TypeOf(registration).PkgPath(), "/")
+	packageName := packageSegments[len(packageSegments)-1]
+
+	resourceIdsPath := filepath.Join(rootPath, "azurerm", "internal", "services", packageName, "resourceids.go")
+	resourceIds, err := parseResourceIds(resourceIdsPath)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource IDs for Service %q: %+v", name, err)
+	}
+
+	return &serviceInfo{
+		name:              name,
+		websiteCategories: websiteCategories,
+		resourceIds:       resourceIds,
+	}, nil
+}
+
+var resourceIdGeneratorRegex = regexp.MustCompile(`-name=(\S+)\s+-id=(\S+)`)
+
+// parseResourceIds parses the Resource ID Generator commands within the `resourceids.go` file, returning a map of the
+// Resource ID Name to an example Resource ID - or an empty map if this file doesn't exist
+func parseResourceIds(filePath string) (map[string]string, error) {
+	output := make(map[string]string)
+
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return output, nil
+		}
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.HasPrefix(line, "//go:generate") {
+			continue
+		}
+
+		if match := resourceIdGeneratorRegex.FindStringSubmatch(line); len(match) == 3 {
+			output[match[1]] = match[2]
+		}
+	}
+
+	return output, nil
+}
+
+// exampleResourceId returns the example Resource ID whose name best matches the Resource Type, that is the longest Resource
+// ID Name which the Resource Type ends with (ignoring casing and underscores), for example `azurerm_key_vault` matches `Vault`
+func exampleResourceId(resourceType string, resourceIds map[string]string) string {
+	normalizedType := strings.ReplaceAll(strings.TrimPrefix(resourceType, "azurerm_"), "_", "")
+
+	bestMatch := ""
+	for name := range resourceIds {
+		normalizedName := strings.ToLower(name)
+		if !strings.HasSuffix(normalizedType, normalizedName) {
+			continue
+		}
+
+		if len(name) > len(bestMatch) || (len(name) == len(bestMatch) && name < bestMatch) {
+			bestMatch = name
+		}
+	}
+
+	if bestMatch == "" {
+		return ""
+	}
+	return resourceIds[bestMatch]
+}
+
+func buildDocument(name string, documentType string, typed bool, info *serviceInfo, resource *schema.Resource) resourceDocument {
+	// Data Sources can share a name with a Resource, but since they can't be imported have no Resource ID
+	exampleId := ""
+	if documentType == documentTypeResource {
+		exampleId = exampleResourceId(name, info.resourceIds)
+	}
+
+	return resourceDocument{
+		Name:               name,
+		Type:               documentType,
+		Service:            info.name,
+		WebsiteCategories:  info.websiteCategories,
+		Typed:              typed,
+		DeprecationMessage: resource.DeprecationMessage,
+		ExampleID:          exampleId,
+		Timeouts:           buildTimeouts(resource.Timeouts),
+		Schema:             buildSchema(resource.Schema),
+	}
+}
+
+func buildTimeouts(input *schema.ResourceTimeout) *timeoutsDocument {
+	if input == nil {
+		return nil
+	}
+
+	format := func(input *time.Duration) string {
+		if input == nil {
+			return ""
+		}
+		return input.String()
+	}
+
+	return &timeoutsDocument{
+		Create: format(input.Create),
+		Read:   format(input.Read),
+		Update: format(input.Update),
+		Delete: format(input.Delete),
+	}
+}
+
+func buildSchema(input map[string]*schema.Schema) map[string]schemaDocument {
+	output := make(map[string]schemaDocument)
+
+	for key, v := range input {
+		field := schemaDocument{
+			Type:          valueTypeName(v.Type),
+			Required:      v.Required,
+			Optional:      v.Optional,
+			Computed:      v.Computed,
+			ForceNew:      v.ForceNew,
+			Sensitive:     v.Sensitive,
+			Deprecated:    v.Deprecated,
+			Default:       v.Default,
+			MinItems:      v.MinItems,
+			MaxItems:      v.MaxItems,
+			ConflictsWith: v.ConflictsWith,
+		}
+
+		switch elem := v.Elem.(type) {
+		case *schema.Schema:
+			field.ElemType = valueTypeName(elem.Type)
+		case *schema.Resource:
+			field.Block = buildSchema(elem.Schema)
+		}
+
+		output[key] = field
+	}
+
+	return output
+}
+
+func valueTypeName(input schema.ValueType) string {
+	switch input {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeSet:
+		return "set"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	rootPath := flag.String("path", "", "The relative path to the root directory")
+	outputPath := flag.String("output-path", "", "The path to the directory where the JSON documents should be written")
+	checkDocs := flag.Bool("check-docs", false, "Check that the arguments/attributes listed in the website documentation match the Schema")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*rootPath, *outputPath, *checkDocs); err != nil {
+		log.Printf("[ERROR] %+v", err)
+		os.Exit(1)
+	}
+}
+
+func run(rootPath string, outputPath string, checkDocs bool) error {
+	documents, err := buildDocuments(rootPath)
+	if err != nil {
+		return fmt.Errorf("building documents: %+v", err)
+	}
+
+	if outputPath != "" {
+		for _, document := range documents {
+			if err := writeDocument(outputPath, document); err != nil {
+				return fmt.Errorf("writing document for %q: %+v", document.Name, err)
+			}
+		}
+		log.Printf("[DEBUG] Exported %d documents to %q", len(documents), outputPath)
+	}
+
+	if checkDocs {
+		websitePath := filepath.Join(rootPath, "website", "docs")
+		problems := make([]string, 0)
+		for _, document := range documents {
+			issues, err := checkWebsiteDocumentation(websitePath, document)
+			if err != nil {
+				return fmt.Errorf("checking documentation for %q: %+v", document.Name, err)
+			}
+			for _, issue := range issues {
+				problems = append(problems, fmt.Sprintf("%s (%s): %s", document.Name, document.Type, issue))
+			}
+		}
+
+		if len(problems) > 0 {
+			return fmt.Errorf("%d problems found in the website documentation:\n\n%s", len(problems), strings.Join(problems, "\n"))
+		}
+	}
+
+	return nil
+}
+
+func writeDocument(outputPath string, document resourceDocument) error {
+	directory := filepath.Join(outputPath, "resources")
+	if document.Type == documentTypeDataSource {
+		directory = filepath.Join(outputPath, "data-sources")
+	}
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", directory, err)
+	}
+
+	contents, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing: %+v", err)
+	}
+
+	fileName := filepath.Join(directory, fmt.Sprintf("%s.json", document.Name))
+	return ioutil.WriteFile(fileName, contents, 0644)
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestExampleResourceId(t *testing.T) {
+	resourceIds := map[string]string{
+		"Vault":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+		"VaultSecret": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1",
+	}
+
+	cases := []struct {
+		resourceType string
+		expected     string
+	}{
+		{
+			resourceType: "azurerm_key_vault",
+			expected:     resourceIds["Vault"],
+		},
+		{
+			resourceType: "azurerm_key_vault_secret",
+			expected:     resourceIds["VaultSecret"],
+		},
+		{
+			resourceType: "azurerm_key_vault_access_policy",
+			expected:     "",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := exampleResourceId(v.resourceType, resourceIds); actual != v.expected {
+			t.Fatalf("Expected %q but got %q for %q", v.expected, actual, v.resourceType)
+		}
+	}
+}
+
+func TestBuildDocument(t *testing.T) {
+	resource := &schema.Resource{
+		DeprecationMessage: "this is deprecated",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ports": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	info := &serviceInfo{
+		name:              "Example",
+		websiteCategories: []string{"Example"},
+		resourceIds: map[string]string{
+			"Example": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Example/examples/example1",
+		},
+	}
+
+	actual := buildDocument("azurerm_example", documentTypeResource, false, info, resource)
+	expected := resourceDocument{
+		Name:               "azurerm_example",
+		Type:               documentTypeResource,
+		Service:            "Example",
+		WebsiteCategories:  []string{"Example"},
+		DeprecationMessage: "this is deprecated",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Example/examples/example1",
+		Timeouts: &timeoutsDocument{
+			Create: "30m0s",
+			Read:   "5m0s",
+		},
+		Schema: map[string]schemaDocument{
+			"name": {
+				Type:     "string",
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      "string",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rule": {
+				Type:     "list",
+				Optional: true,
+				MaxItems: 1,
+				Block: map[string]schemaDocument{
+					"ports": {
+						Type:     "set",
+						Computed: true,
+						ElemType: "int",
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected:\n\n%+v\n\nbut got:\n\n%+v", expected, actual)
+	}
+
+	dataSource := buildDocument("azurerm_example", documentTypeDataSource, false, info, resource)
+	if dataSource.ExampleID != "" {
+		t.Fatalf("Expected no Example ID for a Data Source but got %q", dataSource.ExampleID)
+	}
+}
+
+func TestWebsiteDocumentation(t *testing.T) {
+	contents := "---\n" +
+		"subcategory: \"Example\"\n" +
+		"---\n\n" +
+		"## Arguments Reference\n\n" +
+		"* `name` - (Required) The name of the Example.\n\n" +
+		"* `location` - (Required) The location of the Example.\n\n" +
+		"* `sku` - (Required) The SKU of the Example. Possible values are:\n\n" +
+		" * `Basic` - A Basic SKU.\n" +
+		"  * `Standard` - A Standard SKU.\n\n" +
+		"* `rule` - (Optional) A `rule` block as defined below.\n\n" +
+		"---\n\n" +
+		"A `rule` block supports the following:\n\n" +
+		"* `ports` - (Required) The ports.\n\n" +
+		"## Attributes Reference\n\n" +
+		"* `id` - The ID of the Example.\n\n" +
+		"* `endpoint` - The Endpoint of the Example.\n\n" +
+		"## Timeouts\n\n" +
+		"* `create` - (Defaults to 30 minutes) Used when creating the Example.\n"
+
+	docs := parseWebsiteDocumentation(contents)
+	fields := map[string]schemaDocument{
+		"name": {
+			Type:     "string",
+			Required: true,
+		},
+		"password": {
+			Type:     "string",
+			Optional: true,
+		},
+		"legacy": {
+			Type:       "string",
+			Optional:   true,
+			Deprecated: "this will be removed in 3.0",
+		},
+		"rule": {
+			Type:     "list",
+			Optional: true,
+		},
+		"sku": {
+			Type:     "string",
+			Required: true,
+		},
+		"fqdn": {
+			Type:     "string",
+			Computed: true,
+		},
+	}
+
+	actual := compareWebsiteDocumentation(docs, documentTypeResource, fields)
+	expected := []string{
+		`the attribute "fqdn" is not documented in the Attributes Reference`,
+		`the argument "password" is not documented in the Arguments Reference`,
+		`the field "location" is documented but doesn't exist in the Schema`,
+		`the field "endpoint" is documented but doesn't exist in the Schema`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected:\n\n%+v\n\nbut got:\n\n%+v", expected, actual)
+	}
+}
+
+func TestWebsiteDocumentationDataSource(t *testing.T) {
+	contents := "## Argument Reference\n\n" +
+		"* `name` - The name of the Example.\n\n" +
+		"## Attributes Reference\n\n" +
+		"* `id` - The ID of the Example.\n\n" +
+		"* `tags` - A mapping of tags assigned to the Example.\n\n" +
+		"* `zones` - A list of Availability Zones.\n"
+
+	docs := parseWebsiteDocumentation(contents)
+	fields := map[string]schemaDocument{
+		"name": {
+			Type:     "string",
+			Required: true,
+		},
+		"tags": {
+			Type:     "map",
+			Optional: true,
+		},
+		"zones": {
+			Type:     "list",
+			Optional: true,
+			Computed: true,
+		},
+		"location": {
+			Type:     "string",
+			Optional: true,
+		},
+	}
+
+	actual := compareWebsiteDocumentation(docs, documentTypeDataSource, fields)
+	expected := []string{
+		`the argument "location" is not documented in the Arguments Reference`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected:\n\n%+v\n\nbut got:\n\n%+v", expected, actual)
+	}
+}
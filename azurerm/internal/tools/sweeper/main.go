@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/sweepers"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", true, "Output the leaked resources which would be removed, without removing them")
+	minimumAge := flag.Duration("minimum-age", 24*time.Hour, "The minimum age of a resource before it's considered leaked")
+	includeUnknownAge := flag.Bool("include-unknown-age", false, "Remove resources whose age cannot be determined")
+	names := flag.String("sweepers", "", "A comma-separated list of Sweepers to run, defaults to all Sweepers")
+	endpoint := flag.String("endpoint", "", "Overrides the Resource Manager endpoint, for example to use a fake endpoint")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*dryRun, *minimumAge, *includeUnknownAge, *names, *endpoint); err != nil {
+		log.Printf("[ERROR] %+v", err)
+		os.Exit(1)
+	}
+}
+
+func run(dryRun bool, minimumAge time.Duration, includeUnknownAge bool, names string, endpoint string) error {
+	ctx := context.TODO()
+
+	sweeperNames := make([]string, 0)
+	if names != "" {
+		sweeperNames = strings.Split(names, ",")
+	}
+	toRun, err := sweepers.Filter(sweepers.All(), sweeperNames)
+	if err != nil {
+		return err
+	}
+
+	subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
+	var authorizer autorest.Authorizer = autorest.NullAuthorizer{}
+	if endpoint == "" {
+		endpoint, authorizer, err = buildAuthorizer(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	client := sweepers.NewClient(endpoint, subscriptionId, authorizer)
+	options := sweepers.Options{
+		DryRun:            dryRun,
+		MinimumAge:        minimumAge,
+		IncludeUnknownAge: includeUnknownAge,
+	}
+	results, err := sweepers.Run(ctx, client, toRun, options)
+	for _, result := range results {
+		fmt.Println(result.String())
+	}
+	if dryRun {
+		fmt.Printf("\nDry Run: %d leaked resources would be removed - re-run with `-dry-run=false` to remove them\n", len(results))
+	}
+
+	return err
+}
+
+func buildAuthorizer(ctx context.Context) (string, autorest.Authorizer, error) {
+	environment, exists := os.LookupEnv("ARM_ENVIRONMENT")
+	if !exists {
+		environment = "public"
+	}
+
+	// we intentionally only support Client Secret auth, to match the Acceptance Tests
+	builder := authentication.Builder{
+		SubscriptionID:           os.Getenv("ARM_SUBSCRIPTION_ID"),
+		ClientID:                 os.Getenv("ARM_CLIENT_ID"),
+		TenantID:                 os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:             os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:              environment,
+		MetadataHost:             os.Getenv("ARM_METADATA_HOST"),
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return "", nil, fmt.Errorf("building Auth Config: %+v", err)
+	}
+
+	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, config.MetadataHost, config.Environment)
+	if err != nil {
+		return "", nil, fmt.Errorf("retrieving Environment: %+v", err)
+	}
+
+	oauthConfig, err := config.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
+	if err != nil {
+		return "", nil, fmt.Errorf("building OAuth Config: %+v", err)
+	}
+
+	auth, err := config.GetAuthorizationToken(sender.BuildSender("AzureRM"), oauthConfig, env.TokenAudience)
+	if err != nil {
+		return "", nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	return env.ResourceManagerEndpoint, auth, nil
+}